@@ -0,0 +1,3 @@
+data "prowlarr_application_schema" "example" {
+  implementation = "Sonarr"
+}
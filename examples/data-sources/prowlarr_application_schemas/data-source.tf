@@ -0,0 +1,2 @@
+data "prowlarr_application_schemas" "example" {
+}
@@ -0,0 +1,3 @@
+data "prowlarr_connectivity_test" "example" {
+  kinds = ["applications", "download_clients"]
+}
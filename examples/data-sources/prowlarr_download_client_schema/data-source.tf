@@ -0,0 +1,3 @@
+data "prowlarr_download_client_schema" "example" {
+  implementation = "Transmission"
+}
@@ -0,0 +1,2 @@
+data "prowlarr_download_client_schemas" "example" {
+}
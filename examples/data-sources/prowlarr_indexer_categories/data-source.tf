@@ -0,0 +1,7 @@
+data "prowlarr_indexer_categories" "example" {
+}
+
+# select the TV subcategories
+locals {
+  tv_categories = [for c in data.prowlarr_indexer_categories.example.categories : c.id if c.parent_id == 5000]
+}
@@ -0,0 +1,3 @@
+data "prowlarr_indexer_proxy_schema" "example" {
+  implementation = "FlareSolverr"
+}
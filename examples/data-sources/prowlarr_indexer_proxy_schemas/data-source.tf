@@ -0,0 +1,2 @@
+data "prowlarr_indexer_proxy_schemas" "example" {
+}
@@ -1,3 +1,7 @@
 data "prowlarr_indexer_schema" "test" {
   name = "AlphaRatio"
-}
\ No newline at end of file
+}
+# settings defaults of the non advanced fields
+locals {
+  defaults = { for f in data.prowlarr_indexer_schema.test.fields : f.name => f.default if !f.advanced && f.default != null }
+}
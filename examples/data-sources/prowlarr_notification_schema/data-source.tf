@@ -0,0 +1,3 @@
+data "prowlarr_notification_schema" "example" {
+  implementation = "Discord"
+}
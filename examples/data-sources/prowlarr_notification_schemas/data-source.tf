@@ -0,0 +1,2 @@
+data "prowlarr_notification_schemas" "example" {
+}
@@ -0,0 +1,7 @@
+resource "prowlarr_application_sync" "example" {
+  triggers = {
+    application = prowlarr_application_sonarr.example.id
+    indexer     = prowlarr_indexer.example.id
+  }
+  timeout = 600
+}
@@ -0,0 +1,7 @@
+resource "prowlarr_command" "example" {
+  name = "Backup"
+  triggers = {
+    version = data.prowlarr_system_status.example.version
+  }
+  timeout = 600
+}
@@ -6,6 +6,13 @@ resource "prowlarr_indexer" "example" {
   protocol        = "torrent"
   tags            = [1, 2, 5]
 
+  download_client_id = 1
+
+  torrent_settings = {
+    seed_ratio = 0.5
+    seed_time  = 5
+  }
+
   fields = [
     {
       name       = "username"
@@ -23,13 +30,22 @@ resource "prowlarr_indexer" "example" {
       name      = "mediums"
       set_value = [1, 3]
     },
-    {
-      name         = "torrentBaseSettings.seedRatio"
-      number_value = 0.5
-    },
-    {
-      name         = "torrentBaseSettings.seedTime"
-      number_value = 5
-    },
   ]
-}
\ No newline at end of file
+}
+resource "prowlarr_indexer" "settings" {
+  enable          = true
+  name            = "Newznab"
+  implementation  = "Newznab"
+  config_contract = "NewznabSettings"
+  protocol        = "usenet"
+
+  settings = {
+    baseUrl    = "https://lolo.sickbeard.com"
+    apiPath    = "/api"
+    categories = "2000,5000"
+  }
+
+  sensitive_settings = {
+    apiKey = "test"
+  }
+}
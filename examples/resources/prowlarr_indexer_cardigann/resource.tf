@@ -0,0 +1,11 @@
+resource "prowlarr_indexer_cardigann" "example" {
+  enable     = true
+  name       = "1337x"
+  definition = "1337x"
+
+  settings = {
+    "baseUrl"                       = "https://1337x.to/"
+    "downloadlink"                  = "1"
+    "torrentBaseSettings.seedRatio" = "0.5"
+  }
+}
@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import prowlarr_indexer_newznab.example 1
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "prowlarr_indexer_newznab" "example" {
+  enable     = true
+  name       = "Example"
+  base_url   = "https://lolo.sickbeard.com"
+  api_path   = "/api"
+  api_key    = "APIKey"
+  categories = [2000, 5000]
+}
@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import prowlarr_indexer_torznab.example 1
\ No newline at end of file
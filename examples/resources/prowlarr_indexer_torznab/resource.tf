@@ -0,0 +1,11 @@
+resource "prowlarr_indexer_torznab" "example" {
+  enable          = true
+  name            = "Example"
+  base_url        = "https://example.torznab.com"
+  api_path        = "/api"
+  api_key         = "APIKey"
+  categories      = [2000, 5000]
+  minimum_seeders = 1
+  seed_ratio      = 0.5
+  seed_time       = 60
+}
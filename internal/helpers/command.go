@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+// define constant for command management.
+const (
+	CommandPollWaitMin = time.Second
+	CommandPollWaitMax = 10 * time.Second
+)
+
+// terminalCommandStatuses are the statuses of a command which is not running anymore.
+var terminalCommandStatuses = []prowlarr.CommandStatus{
+	prowlarr.COMMANDSTATUS_COMPLETED,
+	prowlarr.COMMANDSTATUS_FAILED,
+	prowlarr.COMMANDSTATUS_ABORTED,
+	prowlarr.COMMANDSTATUS_CANCELLED,
+	prowlarr.COMMANDSTATUS_ORPHANED,
+}
+
+// IsCommandTerminal checks if the command status is a final one.
+func IsCommandTerminal(status prowlarr.CommandStatus) bool {
+	return slices.Contains(terminalCommandStatuses, status)
+}
+
+// CommandError returns an error if the command did not complete successfully.
+func CommandError(command *prowlarr.CommandResource) error {
+	if command.GetStatus() == prowlarr.COMMANDSTATUS_COMPLETED {
+		return nil
+	}
+
+	message := command.GetMessage()
+	if exception := command.GetException(); exception != "" {
+		message = exception
+	}
+
+	return fmt.Errorf("command %d (%s) %s: %s", command.GetId(), command.GetName(), command.GetStatus(), message)
+}
+
+// WaitForCommand polls Prowlarr command endpoint until the command reaches a final status or the timeout expires.
+// It returns the last read command and an error if the command did not complete successfully.
+func WaitForCommand(ctx context.Context, client *prowlarr.APIClient, id int32, timeout, minWait, maxWait time.Duration) (*prowlarr.CommandResource, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var command *prowlarr.CommandResource
+
+	for attempt := 0; ; attempt++ {
+		response, _, err := client.CommandApi.GetCommandById(ctx, id).Execute()
+
+		switch {
+		case err != nil && ctx.Err() == nil:
+			return command, err
+		case err == nil:
+			command = response
+			if IsCommandTerminal(command.GetStatus()) {
+				return command, CommandError(command)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, fmt.Errorf("command %d not completed after %s, last status: %s", id, timeout, command.GetStatus())
+		case <-time.After(Backoff(attempt, minWait, maxWait)):
+		}
+	}
+}
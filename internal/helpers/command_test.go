@@ -0,0 +1,169 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCommandTerminal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   prowlarr.CommandStatus
+		expected bool
+	}{
+		"queued": {
+			status:   prowlarr.COMMANDSTATUS_QUEUED,
+			expected: false,
+		},
+		"started": {
+			status:   prowlarr.COMMANDSTATUS_STARTED,
+			expected: false,
+		},
+		"completed": {
+			status:   prowlarr.COMMANDSTATUS_COMPLETED,
+			expected: true,
+		},
+		"failed": {
+			status:   prowlarr.COMMANDSTATUS_FAILED,
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsCommandTerminal(test.status))
+		})
+	}
+}
+
+func TestCommandError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		command  func() *prowlarr.CommandResource
+		expected string
+	}{
+		"completed": {
+			command: func() *prowlarr.CommandResource {
+				command := prowlarr.NewCommandResource()
+				command.SetStatus(prowlarr.COMMANDSTATUS_COMPLETED)
+
+				return command
+			},
+			expected: "",
+		},
+		"failed": {
+			command: func() *prowlarr.CommandResource {
+				command := prowlarr.NewCommandResource()
+				command.SetId(7)
+				command.SetName("ApplicationIndexerSync")
+				command.SetStatus(prowlarr.COMMANDSTATUS_FAILED)
+				command.SetMessage("Failed")
+				command.SetException("connection refused")
+
+				return command
+			},
+			expected: "command 7 (ApplicationIndexerSync) failed: connection refused",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CommandError(test.command())
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		running int
+		final   string
+		timeout time.Duration
+		status  prowlarr.CommandStatus
+		err     string
+	}{
+		"completed": {
+			running: 2,
+			final:   "completed",
+			timeout: time.Second,
+			status:  prowlarr.COMMANDSTATUS_COMPLETED,
+		},
+		"failed": {
+			running: 1,
+			final:   "failed",
+			timeout: time.Second,
+			status:  prowlarr.COMMANDSTATUS_FAILED,
+			err:     "command 1 (ApplicationIndexerSync) failed: ",
+		},
+		"timeout": {
+			running: 1000,
+			final:   "completed",
+			timeout: 50 * time.Millisecond,
+			status:  prowlarr.COMMANDSTATUS_STARTED,
+			err:     "command 1 not completed after 50ms, last status: started",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				calls int
+				mu    sync.Mutex
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				calls++
+				status := test.final
+				if calls <= test.running {
+					status = "started"
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"id":1,"name":"ApplicationIndexerSync","status":"%s"}`, status)
+			}))
+			defer server.Close()
+
+			config := prowlarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+
+			command, err := WaitForCommand(context.TODO(), prowlarr.NewAPIClient(config), 1, test.timeout, time.Millisecond, 5*time.Millisecond)
+			assert.Equal(t, test.status, command.GetStatus())
+			assert.Equal(t, int32(1), command.GetId())
+
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
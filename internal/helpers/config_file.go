@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ConfigXML contains the relevant settings of Prowlarr config.xml file.
+type ConfigXML struct {
+	XMLName xml.Name `xml:"Config"`
+	APIKey  string   `xml:"ApiKey"`
+	URLBase string   `xml:"UrlBase"`
+	Port    int64    `xml:"Port"`
+}
+
+// ReadAPIKeyFile reads the API key from a plain text file (e.g. a docker or kubernetes secret).
+func ReadAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read API key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(content))
+	if key == "" {
+		return "", fmt.Errorf("API key file %s is empty", path)
+	}
+
+	return key, nil
+}
+
+// ReadConfigXML reads the API key, port and URL base from Prowlarr config.xml file.
+func ReadConfigXML(path string) (*ConfigXML, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config.xml: %w", err)
+	}
+
+	var config ConfigXML
+	if err := xml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse config.xml: %w", err)
+	}
+
+	config.APIKey = strings.TrimSpace(config.APIKey)
+	config.URLBase = strings.TrimSpace(config.URLBase)
+
+	if config.APIKey == "" {
+		return nil, errors.New("no ApiKey found in config.xml")
+	}
+
+	return &config, nil
+}
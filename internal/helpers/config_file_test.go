@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestFile writes the content into a temporary file and returns its path.
+func writeTestFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "file")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestReadAPIKeyFile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		expected string
+		err      bool
+	}{
+		"working": {
+			content:  "abcdef0123456789\n",
+			expected: "abcdef0123456789",
+		},
+		"empty": {
+			content: " \n",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := ReadAPIKeyFile(writeTestFile(t, test.content))
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, key)
+		})
+	}
+
+	_, err := ReadAPIKeyFile(filepath.Join(t.TempDir(), "missing"))
+	assert.NotNil(t, err)
+}
+
+func TestReadConfigXML(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		expected *ConfigXML
+		err      bool
+	}{
+		"working": {
+			content: `<Config>
+  <BindAddress>*</BindAddress>
+  <Port>9696</Port>
+  <SslPort>6969</SslPort>
+  <EnableSsl>False</EnableSsl>
+  <ApiKey>abcdef0123456789</ApiKey>
+  <AuthenticationMethod>Forms</AuthenticationMethod>
+  <UrlBase>/prowlarr</UrlBase>
+</Config>`,
+			expected: &ConfigXML{APIKey: "abcdef0123456789", Port: 9696, URLBase: "/prowlarr"},
+		},
+		"missing key": {
+			content: `<Config><Port>9696</Port></Config>`,
+			err:     true,
+		},
+		"invalid": {
+			content: `not xml`,
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := ReadConfigXML(writeTestFile(t, test.content))
+			assert.Equal(t, test.err, err != nil)
+
+			if test.expected != nil {
+				assert.Equal(t, test.expected.APIKey, config.APIKey)
+				assert.Equal(t, test.expected.Port, config.Port)
+				assert.Equal(t, test.expected.URLBase, config.URLBase)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package helpers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultTagsName = "default_tags"
+
+// DefaultTags resolves the provider default tag labels into Prowlarr tag IDs
+// to be merged into every taggable resource.
+type DefaultTags struct {
+	client *prowlarr.APIClient
+	ids    map[string]int64
+	labels []string
+	mu     sync.Mutex
+}
+
+// NewDefaultTags returns the default tags for the given labels, nil if no label is provided.
+func NewDefaultTags(client *prowlarr.APIClient, labels []string) *DefaultTags {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	return &DefaultTags{
+		client: client,
+		labels: labels,
+		ids:    make(map[string]int64, len(labels)),
+	}
+}
+
+// ModifyPlan merges the default tags into the planned tags, so that the plan shows the effective set.
+// If some default tag does not exist yet, the planned tags are unknown until apply.
+func (t *DefaultTags) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or without default tags.
+	if t == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+
+	if resp.Diagnostics.HasError() || tags.IsUnknown() {
+		return
+	}
+
+	ids, ok, err := t.resolve(ctx, false)
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(Read, defaultTagsName, err))
+
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	merged, diags := mergeTags(ctx, tags, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), merged)...)
+}
+
+// Apply resolves the tags left unknown during plan, creating the missing default tags.
+func (t *DefaultTags) Apply(ctx context.Context, config tfsdk.Config, tags *types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if t == nil || !tags.IsUnknown() {
+		return diags
+	}
+
+	var configTags types.Set
+
+	diags.Append(config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	ids, _, err := t.resolve(ctx, true)
+	if err != nil {
+		diags.AddError(ClientError, ParseClientError(Create, defaultTagsName, err))
+
+		return diags
+	}
+
+	merged, localDiags := mergeTags(ctx, configTags, ids)
+	diags.Append(localDiags...)
+
+	*tags = merged
+
+	return diags
+}
+
+// resolve returns the IDs of the default tags, creating the missing ones if requested.
+// The boolean is false if some tag does not exist.
+func (t *DefaultTags) resolve(ctx context.Context, create bool) ([]int64, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.missing()) > 0 {
+		response, _, err := t.client.TagApi.ListTag(ctx).Execute()
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, tag := range response {
+			t.ids[strings.ToLower(tag.GetLabel())] = int64(tag.GetId())
+		}
+	}
+
+	missing := t.missing()
+	if len(missing) > 0 && !create {
+		return nil, false, nil
+	}
+
+	for _, label := range missing {
+		request := prowlarr.NewTagResource()
+		request.SetLabel(label)
+
+		response, _, err := t.client.TagApi.CreateTag(ctx).TagResource(*request).Execute()
+		if err != nil {
+			return nil, false, err
+		}
+
+		t.ids[strings.ToLower(response.GetLabel())] = int64(response.GetId())
+	}
+
+	ids := make([]int64, len(t.labels))
+	for i, label := range t.labels {
+		ids[i] = t.ids[strings.ToLower(label)]
+	}
+
+	return ids, true, nil
+}
+
+// missing returns the labels not resolved yet.
+func (t *DefaultTags) missing() []string {
+	missing := make([]string, 0, len(t.labels))
+
+	for _, label := range t.labels {
+		if _, ok := t.ids[strings.ToLower(label)]; !ok {
+			missing = append(missing, label)
+		}
+	}
+
+	return missing
+}
+
+// mergeTags returns the union of the configured tags and the default ones.
+func mergeTags(ctx context.Context, tags types.Set, defaults []int64) (types.Set, diag.Diagnostics) {
+	var (
+		ids   []int64
+		diags diag.Diagnostics
+	)
+
+	if !tags.IsNull() {
+		diags.Append(tags.ElementsAs(ctx, &ids, true)...)
+	}
+
+	for _, id := range defaults {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	merged, localDiags := types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(localDiags...)
+
+	return merged, diags
+}
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTagClient returns a client for a server with the existing tag `managed` and creating new ones with ID 2.
+func testTagClient(t *testing.T, created *int) *prowlarr.APIClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			*created++
+			_, _ = w.Write([]byte(`{"id":2,"label":"terraform"}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1,"label":"managed"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := prowlarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+
+	return prowlarr.NewAPIClient(config)
+}
+
+func TestDefaultTagsResolve(t *testing.T) {
+	t.Parallel()
+
+	var created int
+
+	tags := NewDefaultTags(testTagClient(t, &created), []string{"Managed", "terraform"})
+
+	ids, ok, err := tags.resolve(context.TODO(), false)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, ids)
+	assert.Equal(t, 0, created)
+
+	ids, ok, err = tags.resolve(context.TODO(), true)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, 2}, ids)
+	assert.Equal(t, 1, created)
+
+	// resolved tags are cached
+	_, _, _ = tags.resolve(context.TODO(), true)
+	assert.Equal(t, 1, created)
+
+	assert.Nil(t, NewDefaultTags(nil, nil))
+}
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tags     types.Set
+		expected types.Set
+	}{
+		"null": {
+			tags:     types.SetNull(types.Int64Type),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+		},
+		"duplicated": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2), types.Int64Value(5)}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2), types.Int64Value(5)}),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			merged, diags := mergeTags(context.TODO(), test.tags, []int64{1, 2})
+			assert.False(t, diags.HasError())
+			assert.True(t, test.expected.Equal(merged))
+		})
+	}
+}
@@ -1,9 +1,21 @@
 package helpers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // define constant for error management.
@@ -13,7 +25,9 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	RunTest                           = "test"
 	ClientError                       = "Client Error"
+	ClientWarning                     = "Client Warning"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
@@ -21,6 +35,27 @@ const (
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
 )
 
+// ValidationFailure is a single validation result returned by Prowlarr.
+type ValidationFailure struct {
+	AttemptedValue interface{} `json:"attemptedValue"`
+	PropertyName   string      `json:"propertyName"`
+	ErrorMessage   string      `json:"errorMessage"`
+	Severity       string      `json:"severity"`
+	IsWarning      bool        `json:"isWarning"`
+}
+
+// TestResult is the result of a single provider test, as returned by the test all endpoints.
+type TestResult struct {
+	ValidationFailures []ValidationFailure `json:"validationFailures"`
+	ID                 int64               `json:"id"`
+	IsValid            bool                `json:"isValid"`
+}
+
+// schemaTyper is implemented by all the framework schemas and is used to check if an attribute exists.
+type schemaTyper interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
@@ -32,3 +67,194 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// IsNotFound returns true if the API call failed because the requested object does not exist.
+func IsNotFound(httpResp *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if httpResp != nil {
+		return httpResp.StatusCode == http.StatusNotFound
+	}
+
+	var openAPIErr *prowlarr.GenericOpenAPIError
+	if errors.As(err, &openAPIErr) {
+		return strings.HasPrefix(openAPIErr.Error(), "404")
+	}
+
+	return false
+}
+
+// HandleReadError manages a resource read failure.
+// If the object has been deleted outside of terraform, it is removed from state so that it can be planned for re-creation.
+func HandleReadError(ctx context.Context, name string, httpResp *http.Response, err error, resp *resource.ReadResponse) {
+	if IsNotFound(httpResp, err) {
+		tflog.Warn(ctx, name+" not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(ClientError, ParseClientError(Read, name, err))
+}
+
+// ParseValidationFailures extracts the validation failures from the API error body, if any.
+func ParseValidationFailures(err error) []ValidationFailure {
+	var (
+		openAPIErr *prowlarr.GenericOpenAPIError
+		failures   []ValidationFailure
+	)
+
+	if !errors.As(err, &openAPIErr) {
+		return nil
+	}
+
+	if json.Unmarshal(openAPIErr.Body(), &failures) != nil {
+		return nil
+	}
+
+	return failures
+}
+
+// selectTFPath identifies the terraform attribute path starting from the API property name.
+func selectTFPath(property string) path.Path {
+	segments := strings.Split(property, ".")
+	for i, s := range segments {
+		segments[i] = lowerFirst(s)
+	}
+
+	name := selectTFName(strings.Join(segments, "."))
+	if strings.Contains(name, ".") {
+		return path.Empty()
+	}
+
+	return path.Root(toSnakeCase(name))
+}
+
+// lowerFirst converts the first letter to lower case.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// toSnakeCase converts a camel case name into snake case.
+func toSnakeCase(s string) string {
+	var b strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// addValidationDiagnostic adds a validation failure to diagnostics, as error or warning.
+// The failure is mapped to the related attribute path, when it is part of the resource schema.
+func addValidationDiagnostic(ctx context.Context, diags *diag.Diagnostics, resourceSchema schemaTyper, summary string, failure ValidationFailure, warning bool) {
+	attributePath := selectTFPath(failure.PropertyName)
+	if !attributePath.Equal(path.Empty()) && resourceSchema != nil {
+		if _, d := resourceSchema.TypeAtPath(ctx, attributePath); !d.HasError() {
+			if warning {
+				diags.AddAttributeWarning(attributePath, ClientWarning, fmt.Sprintf("%s: %s", summary, failure.ErrorMessage))
+			} else {
+				diags.AddAttributeError(attributePath, ClientError, fmt.Sprintf("%s: %s", summary, failure.ErrorMessage))
+			}
+
+			return
+		}
+	}
+
+	if warning {
+		diags.AddWarning(ClientWarning, fmt.Sprintf("%s: %s: %s", summary, failure.PropertyName, failure.ErrorMessage))
+	} else {
+		diags.AddError(ClientError, fmt.Sprintf("%s: %s: %s", summary, failure.PropertyName, failure.ErrorMessage))
+	}
+}
+
+// ProcessClientError manages a create or update failure.
+// Prowlarr validation failures are mapped to the related attribute path, when it is part of the resource schema.
+func ProcessClientError(ctx context.Context, diags *diag.Diagnostics, resourceSchema schemaTyper, action, name string, err error) {
+	failures := ParseValidationFailures(err)
+	if len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+
+		return
+	}
+
+	for _, f := range failures {
+		summary := fmt.Sprintf("Unable to %s %s, got validation error", action, name)
+		if f.IsWarning {
+			summary = fmt.Sprintf("Unable to %s %s, got validation warning (use force_save to ignore it)", action, name)
+		}
+
+		addValidationDiagnostic(ctx, diags, resourceSchema, summary, f, false)
+	}
+}
+
+// IsForceSaveNeeded checks if a failed create or update can be submitted again with forceSave.
+// This is true only when force save is requested and all the validation failures are warnings,
+// in that case the failures are added to diagnostics as warnings.
+func IsForceSaveNeeded(ctx context.Context, diags *diag.Diagnostics, resourceSchema schemaTyper, forceSave bool, action, name string, err error) bool {
+	if !forceSave || err == nil {
+		return false
+	}
+
+	failures := ParseValidationFailures(err)
+	if len(failures) == 0 {
+		return false
+	}
+
+	for _, f := range failures {
+		if !f.IsWarning {
+			return false
+		}
+	}
+
+	for _, f := range failures {
+		addValidationDiagnostic(ctx, diags, resourceSchema, fmt.Sprintf("Forced %s of %s, ignoring validation warning", action, name), f, true)
+	}
+
+	return true
+}
+
+// ProcessTestError manages a test on apply failure.
+// Prowlarr validation failures are mapped to the related attribute path, warnings do not fail the apply.
+func ProcessTestError(ctx context.Context, diags *diag.Diagnostics, resourceSchema schemaTyper, name string, err error) {
+	if err == nil {
+		return
+	}
+
+	failures := ParseValidationFailures(err)
+	if len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(RunTest, name, err))
+
+		return
+	}
+
+	for _, f := range failures {
+		addValidationDiagnostic(ctx, diags, resourceSchema, fmt.Sprintf("Test of %s failed", name), f, f.IsWarning)
+	}
+}
+
+// RunTestOnApply runs the test of the saved configuration when enabled by the resource attribute
+// or by the provider default, reporting the test failures as diagnostics.
+func RunTestOnApply(ctx context.Context, diags *diag.Diagnostics, resourceSchema schemaTyper, name string, testOnApply types.Bool, providerDefault bool, test func() error) {
+	if IsTestOnApply(testOnApply, providerDefault) {
+		ProcessTestError(ctx, diags, resourceSchema, name, test())
+	}
+}
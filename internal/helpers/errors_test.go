@@ -1,10 +1,20 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +75,348 @@ func TestParseNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+// testClient returns a prowlarr client pointing to a server replying with the given status code and body.
+func testClient(t *testing.T, status int, body string) *prowlarr.APIClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	config := prowlarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+
+	return prowlarr.NewAPIClient(config)
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		expected bool
+	}{
+		"not found": {
+			status:   http.StatusNotFound,
+			expected: true,
+		},
+		"unauthorized": {
+			status:   http.StatusUnauthorized,
+			expected: false,
+		},
+		"server error": {
+			status:   http.StatusInternalServerError,
+			expected: false,
+		},
+		"ok": {
+			status:   http.StatusOK,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, httpResp, err := testClient(t, test.status, `{"id":1,"label":"test"}`).TagApi.GetTagById(context.TODO(), 1).Execute()
+			assert.Equal(t, test.expected, IsNotFound(httpResp, err))
+			// without http response the status is inferred from the error.
+			assert.Equal(t, test.expected, IsNotFound(nil, err))
+		})
+	}
+
+	assert.False(t, IsNotFound(nil, errors.New("404 generic error")))
+}
+
+func TestHandleReadError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status  int
+		removed bool
+	}{
+		"not found": {
+			status:  http.StatusNotFound,
+			removed: true,
+		},
+		"server error": {
+			status:  http.StatusInternalServerError,
+			removed: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}
+			resp := resource.ReadResponse{
+				State: tfsdk.State{
+					Schema: schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.Int64Attribute{Computed: true}}},
+					Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.Number, 1)}),
+				},
+			}
+
+			_, httpResp, err := testClient(t, test.status, `{"id":1,"label":"test"}`).TagApi.GetTagById(context.TODO(), 1).Execute()
+			HandleReadError(context.TODO(), "prowlarr_tag", httpResp, err, &resp)
+
+			assert.Equal(t, test.removed, resp.State.Raw.IsNull())
+			assert.Equal(t, !test.removed, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+const testValidationBody = `[
+	{"propertyName":"ApiKey","errorMessage":"Invalid API key","severity":"error","isWarning":false},
+	{"propertyName":"SeedCriteria.SeedTime","errorMessage":"Must be positive","severity":"error","isWarning":false},
+	{"propertyName":"BaseSettings.QueryLimit","errorMessage":"Too high","severity":"error","isWarning":false}
+]`
+
+func TestParseValidationFailures(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := testClient(t, http.StatusBadRequest, testValidationBody).TagApi.GetTagById(context.TODO(), 1).Execute()
+	failures := ParseValidationFailures(err)
+
+	assert.Len(t, failures, 3)
+	assert.Equal(t, "ApiKey", failures[0].PropertyName)
+	assert.Equal(t, "Invalid API key", failures[0].ErrorMessage)
+	assert.Nil(t, ParseValidationFailures(errors.New("other error")))
+
+	_, _, err = testClient(t, http.StatusBadRequest, `{"message":"not a list"}`).TagApi.GetTagById(context.TODO(), 1).Execute()
+	assert.Nil(t, ParseValidationFailures(err))
+}
+
+func TestSelectTFPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		property string
+		expected path.Path
+	}{
+		"simple": {
+			property: "ApiKey",
+			expected: path.Root("api_key"),
+		},
+		"exception": {
+			property: "SeedCriteria.SeasonPackSeedTime",
+			expected: path.Root("season_pack_seed_time"),
+		},
+		"lower": {
+			property: "name",
+			expected: path.Root("name"),
+		},
+		"nested": {
+			property: "BaseSettings.QueryLimit",
+			expected: path.Empty(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, selectTFPath(test.property))
+		})
+	}
+}
+
+func TestProcessClientError(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_key":   schema.StringAttribute{Optional: true},
+			"seed_time": schema.Int64Attribute{Optional: true},
+		},
+	}
+
+	var expected diag.Diagnostics
+
+	expected.AddAttributeError(path.Root("api_key"), ClientError, "Unable to create prowlarr_tag, got validation error: Invalid API key")
+	expected.AddAttributeError(path.Root("seed_time"), ClientError, "Unable to create prowlarr_tag, got validation error: Must be positive")
+	expected.AddError(ClientError, "Unable to create prowlarr_tag, got validation error: BaseSettings.QueryLimit: Too high")
+
+	_, _, err := testClient(t, http.StatusBadRequest, testValidationBody).TagApi.GetTagById(context.TODO(), 1).Execute()
+
+	var diags diag.Diagnostics
+
+	ProcessClientError(context.TODO(), &diags, resourceSchema, Create, "prowlarr_tag", err)
+	assert.Equal(t, expected, diags)
+
+	var generic diag.Diagnostics
+
+	ProcessClientError(context.TODO(), &generic, resourceSchema, Create, "prowlarr_tag", errors.New("other error"))
+	assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(ClientError, "Unable to create prowlarr_tag, got error: other error")}, generic)
+}
+
+func TestIsForceSaveNeeded(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	warningBody := `[{"propertyName":"BaseUrl","errorMessage":"Unable to connect","severity":"warning","isWarning":true}]`
+
+	tests := map[string]struct {
+		body      string
+		forceSave bool
+		expected  bool
+		warnings  int
+	}{
+		"warnings forced": {
+			body:      warningBody,
+			forceSave: true,
+			expected:  true,
+			warnings:  1,
+		},
+		"warnings not forced": {
+			body:      warningBody,
+			forceSave: false,
+			expected:  false,
+		},
+		"errors forced": {
+			body:      testValidationBody,
+			forceSave: true,
+			expected:  false,
+		},
+		"generic error": {
+			body:      `not json`,
+			forceSave: true,
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			_, _, err := testClient(t, http.StatusBadRequest, test.body).TagApi.GetTagById(context.TODO(), 1).Execute()
+			assert.Equal(t, test.expected, IsForceSaveNeeded(context.TODO(), &diags, resourceSchema, test.forceSave, Create, "prowlarr_application", err))
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+			assert.False(t, diags.HasError())
+		})
+	}
+
+	var diags diag.Diagnostics
+
+	_, _, err := testClient(t, http.StatusBadRequest, warningBody).TagApi.GetTagById(context.TODO(), 1).Execute()
+	IsForceSaveNeeded(context.TODO(), &diags, resourceSchema, true, Create, "prowlarr_application", err)
+	assert.Equal(t, path.Root("base_url"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestProcessTestError(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	tests := map[string]struct {
+		body     string
+		errors   int
+		warnings int
+	}{
+		"errors": {
+			body:   testValidationBody,
+			errors: 3,
+		},
+		"warnings": {
+			body:     `[{"propertyName":"BaseUrl","errorMessage":"Unable to connect","severity":"warning","isWarning":true}]`,
+			warnings: 1,
+		},
+		"generic error": {
+			body:   `not json`,
+			errors: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			_, _, err := testClient(t, http.StatusBadRequest, test.body).TagApi.GetTagById(context.TODO(), 1).Execute()
+			ProcessTestError(context.TODO(), &diags, resourceSchema, "prowlarr_application", err)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+		})
+	}
+
+	var diags diag.Diagnostics
+
+	ProcessTestError(context.TODO(), &diags, resourceSchema, "prowlarr_application", nil)
+	assert.Empty(t, diags)
+}
+
+func TestRunTestOnApply(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	tests := map[string]struct {
+		testOnApply     types.Bool
+		providerDefault bool
+		called          bool
+		errors          int
+	}{
+		"resource enabled": {
+			testOnApply: types.BoolValue(true),
+			called:      true,
+			errors:      3,
+		},
+		"resource disabled": {
+			testOnApply:     types.BoolValue(false),
+			providerDefault: true,
+		},
+		"provider default": {
+			testOnApply:     types.BoolNull(),
+			providerDefault: true,
+			called:          true,
+			errors:          3,
+		},
+		"disabled": {
+			testOnApply: types.BoolNull(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			called := false
+
+			RunTestOnApply(context.TODO(), &diags, resourceSchema, "prowlarr_application", test.testOnApply, test.providerDefault, func() error {
+				called = true
+				_, _, err := testClient(t, http.StatusBadRequest, testValidationBody).TagApi.GetTagById(context.TODO(), 1).Execute()
+
+				return err
+			})
+			assert.Equal(t, test.called, called)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+		})
+	}
+}
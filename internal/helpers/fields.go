@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// MaskedValue is the placeholder returned by Prowlarr in place of secrets.
+const MaskedValue = "********"
+
 type fieldException struct {
 	apiName string
 	tfName  string
@@ -39,6 +42,22 @@ func getFieldExceptions() []fieldException {
 			apiName: "seedCriteria.seasonPackSeedTime",
 			tfName:  "seasonPackSeedTime",
 		},
+		{
+			apiName: "torrentBaseSettings.appMinimumSeeders",
+			tfName:  "torrentMinimumSeeders",
+		},
+		{
+			apiName: "torrentBaseSettings.seedRatio",
+			tfName:  "torrentSeedRatio",
+		},
+		{
+			apiName: "torrentBaseSettings.seedTime",
+			tfName:  "torrentSeedTime",
+		},
+		{
+			apiName: "torrentBaseSettings.packSeedTime",
+			tfName:  "torrentPackSeedTime",
+		},
 	}
 }
 
@@ -89,16 +108,44 @@ func setField(name string, value interface{}) *prowlarr.Field {
 	return field
 }
 
+// MergeFields returns the base fields with the overlay ones replacing or appended to them by name.
+// It is used to send back the fields not managed by Terraform, since Prowlarr resets the missing ones.
+func MergeFields(base, overlay []*prowlarr.Field) []*prowlarr.Field {
+	merged := slices.Clone(base)
+
+	for _, field := range overlay {
+		index := slices.IndexFunc(merged, func(f *prowlarr.Field) bool { return f.GetName() == field.GetName() })
+		if index < 0 {
+			merged = append(merged, field)
+		} else {
+			merged[index] = field
+		}
+	}
+
+	return merged
+}
+
+// UnmaskString returns the prior value if the API returned a masked secret and the prior value is known.
+// Otherwise the API value is returned, so that a real remote change is detected.
+func UnmaskString(value, prior types.String) types.String {
+	if value.ValueString() == MaskedValue && !prior.IsNull() && !prior.IsUnknown() {
+		return prior
+	}
+
+	return value
+}
+
 // writeStringField writes a prowlarr string field into struct field.
 func writeStringField(fieldOutput *prowlarr.Field, fieldCase interface{}) {
-	stringValue := fmt.Sprint(fieldOutput.GetValue())
+	field := selectWriteField(fieldOutput, fieldCase)
 
-	v := reflect.ValueOf(types.StringValue(stringValue))
-	if fieldOutput.GetValue() == nil {
-		v = reflect.ValueOf(types.StringNull())
+	v := reflect.ValueOf(types.StringNull())
+	if fieldOutput.GetValue() != nil {
+		prior, _ := field.Interface().(types.String)
+		v = reflect.ValueOf(UnmaskString(types.StringValue(fmt.Sprint(fieldOutput.GetValue())), prior))
 	}
 
-	selectWriteField(fieldOutput, fieldCase).Set(v)
+	field.Set(v)
 }
 
 // writeBoolField writes a prowlarr bool field into struct field.
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
@@ -23,6 +24,7 @@ func TestWriteStringField(t *testing.T) {
 	t.Parallel()
 
 	value := "string"
+	masked := MaskedValue
 
 	tests := map[string]struct {
 		fieldOutput prowlarr.Field
@@ -40,6 +42,21 @@ func TestWriteStringField(t *testing.T) {
 			written:  Test{},
 			expected: Test{Str: types.StringNull()},
 		},
+		"masked": {
+			value:    &masked,
+			written:  Test{Str: types.StringValue("secret")},
+			expected: Test{Str: types.StringValue("secret")},
+		},
+		"masked without prior": {
+			value:    &masked,
+			written:  Test{},
+			expected: Test{Str: types.StringValue(MaskedValue)},
+		},
+		"remote change": {
+			value:    &value,
+			written:  Test{Str: types.StringValue("secret")},
+			expected: Test{Str: types.StringValue(value)},
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -57,6 +74,87 @@ func TestWriteStringField(t *testing.T) {
 	}
 }
 
+func TestUnmaskString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		prior    types.String
+		expected types.String
+	}{
+		"masked": {
+			value:    types.StringValue(MaskedValue),
+			prior:    types.StringValue("secret"),
+			expected: types.StringValue("secret"),
+		},
+		"masked null prior": {
+			value:    types.StringValue(MaskedValue),
+			prior:    types.StringNull(),
+			expected: types.StringValue(MaskedValue),
+		},
+		"masked unknown prior": {
+			value:    types.StringValue(MaskedValue),
+			prior:    types.StringUnknown(),
+			expected: types.StringValue(MaskedValue),
+		},
+		"changed": {
+			value:    types.StringValue("new"),
+			prior:    types.StringValue("secret"),
+			expected: types.StringValue("new"),
+		},
+		"removed": {
+			value:    types.StringNull(),
+			prior:    types.StringValue("secret"),
+			expected: types.StringNull(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, UnmaskString(test.value, test.prior))
+		})
+	}
+}
+
+func TestMergeFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		base     []*prowlarr.Field
+		overlay  []*prowlarr.Field
+		expected []*prowlarr.Field
+	}{
+		"replace": {
+			base:     []*prowlarr.Field{setField("apiKey", "key"), setField("baseSettings.queryLimit", 10)},
+			overlay:  []*prowlarr.Field{setField("baseSettings.queryLimit", 2)},
+			expected: []*prowlarr.Field{setField("apiKey", "key"), setField("baseSettings.queryLimit", 2)},
+		},
+		"append": {
+			base:     []*prowlarr.Field{setField("apiKey", "key")},
+			overlay:  []*prowlarr.Field{setField("baseUrl", "http://example.com")},
+			expected: []*prowlarr.Field{setField("apiKey", "key"), setField("baseUrl", "http://example.com")},
+		},
+		"empty base": {
+			base:     nil,
+			overlay:  []*prowlarr.Field{setField("baseUrl", "http://example.com")},
+			expected: []*prowlarr.Field{setField("baseUrl", "http://example.com")},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			base := slices.Clone(test.base)
+			assert.Equal(t, test.expected, MergeFields(test.base, test.overlay))
+			assert.Equal(t, base, test.base)
+		})
+	}
+}
+
 func TestWriteBoolField(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+// define header names used for authentication.
+const (
+	apiKeyHeader        = "X-Api-Key"
+	authorizationHeader = "Authorization"
+)
+
+// ClientHeaders contains the authentication and additional headers of the provider HTTP client.
+type ClientHeaders struct {
+	Extra         map[string]string
+	APIKey        string
+	Authorization string
+	BasicUsername string
+	BasicPassword string
+}
+
+// Build returns the default headers to be added to each request.
+// Extra headers cannot override the authentication ones.
+func (h ClientHeaders) Build() (map[string]string, error) {
+	headers := make(map[string]string, len(h.Extra)+2)
+
+	if h.Authorization != "" && h.BasicUsername != "" {
+		return nil, fmt.Errorf("'authorization' and 'basic_auth' both set the %s header, only one can be provided", authorizationHeader)
+	}
+
+	for k, v := range h.Extra {
+		name := http.CanonicalHeaderKey(k)
+		if (name == apiKeyHeader && h.APIKey != "") || (name == authorizationHeader && (h.Authorization != "" || h.BasicUsername != "")) {
+			return nil, fmt.Errorf("extra header %s conflicts with the provider authentication", name)
+		}
+
+		headers[name] = v
+	}
+
+	if h.APIKey != "" {
+		headers[apiKeyHeader] = h.APIKey
+	}
+
+	if h.Authorization != "" {
+		headers[authorizationHeader] = h.Authorization
+	}
+
+	if h.BasicUsername != "" {
+		headers[authorizationHeader] = "Basic " + base64.StdEncoding.EncodeToString([]byte(h.BasicUsername+":"+h.BasicPassword))
+	}
+
+	return headers, nil
+}
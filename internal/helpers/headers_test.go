@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientHeadersBuild(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		headers  ClientHeaders
+		expected map[string]string
+		err      bool
+	}{
+		"api key": {
+			headers:  ClientHeaders{APIKey: "key"},
+			expected: map[string]string{"X-Api-Key": "key"},
+		},
+		"extra": {
+			headers:  ClientHeaders{APIKey: "key", Extra: map[string]string{"x-service-token": "token"}},
+			expected: map[string]string{"X-Api-Key": "key", "X-Service-Token": "token"},
+		},
+		"basic auth": {
+			headers:  ClientHeaders{APIKey: "key", BasicUsername: "user", BasicPassword: "pass"},
+			expected: map[string]string{"X-Api-Key": "key", "Authorization": "Basic dXNlcjpwYXNz"},
+		},
+		"extra authorization": {
+			headers:  ClientHeaders{APIKey: "key", Extra: map[string]string{"authorization": "Bearer token"}},
+			expected: map[string]string{"X-Api-Key": "key", "Authorization": "Bearer token"},
+		},
+		"authorization conflict": {
+			headers: ClientHeaders{Authorization: "token", BasicUsername: "user"},
+			err:     true,
+		},
+		"extra api key conflict": {
+			headers: ClientHeaders{APIKey: "key", Extra: map[string]string{"x-api-key": "other"}},
+			err:     true,
+		},
+		"extra authorization conflict": {
+			headers: ClientHeaders{Authorization: "token", Extra: map[string]string{"Authorization": "other"}},
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			headers, err := test.headers.Build()
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, headers)
+		})
+	}
+}
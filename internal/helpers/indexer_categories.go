@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+// IndexerCategories returns the indexer categories, fetching them only once per provider.
+func (p *ProviderData) IndexerCategories(ctx context.Context) ([]*prowlarr.IndexerCategory, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.indexerCategories != nil {
+		return p.indexerCategories, nil
+	}
+
+	response, _, err := p.Client.IndexerDefaultCategoriesApi.ListIndexerCategories(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	p.indexerCategories = response
+
+	return response, nil
+}
+
+// FlattenIndexerCategories returns the categories followed by their subcategories as a single list.
+func FlattenIndexerCategories(categories []*prowlarr.IndexerCategory) []*prowlarr.IndexerCategory {
+	list := make([]*prowlarr.IndexerCategory, 0, len(categories))
+
+	for _, c := range categories {
+		list = append(list, c)
+		list = append(list, FlattenIndexerCategories(c.GetSubCategories())...)
+	}
+
+	return list
+}
+
+// UnknownIndexerCategories returns an error message for each ID not part of the categories or of their subcategories.
+func UnknownIndexerCategories(categories []*prowlarr.IndexerCategory, ids []int64) []string {
+	categories = FlattenIndexerCategories(categories)
+	messages := []string{}
+
+	for _, id := range ids {
+		if message := UnknownIndexerCategory(categories, id); message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+// UnknownIndexerCategory returns an error message if the ID is not part of the flattened categories.
+// The message suggests the category with the nearest ID, since the categories are matched only by ID.
+func UnknownIndexerCategory(categories []*prowlarr.IndexerCategory, id int64) string {
+	var closest *prowlarr.IndexerCategory
+
+	for _, c := range categories {
+		distance := int64(c.GetId()) - id
+		if distance == 0 {
+			return ""
+		}
+
+		if closest == nil || abs(distance) < abs(int64(closest.GetId())-id) {
+			closest = c
+		}
+	}
+
+	if closest == nil {
+		return fmt.Sprintf("Unknown category ID %d.", id)
+	}
+
+	return fmt.Sprintf("Unknown category ID %d. The nearest category ID is %d (%s).", id, closest.GetId(), closest.GetName())
+}
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
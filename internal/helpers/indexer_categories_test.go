@@ -0,0 +1,116 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func testIndexerCategory(id int32, name string, subCategories ...*prowlarr.IndexerCategory) *prowlarr.IndexerCategory {
+	category := prowlarr.NewIndexerCategory()
+	category.SetId(id)
+	category.SetName(name)
+	category.SetSubCategories(subCategories)
+
+	return category
+}
+
+func testIndexerCategories() []*prowlarr.IndexerCategory {
+	return []*prowlarr.IndexerCategory{
+		testIndexerCategory(2000, "Movies",
+			testIndexerCategory(2030, "Movies/SD"),
+			testIndexerCategory(2040, "Movies/HD"),
+		),
+		testIndexerCategory(5000, "TV",
+			testIndexerCategory(5070, "TV/Anime"),
+		),
+	}
+}
+
+func TestFlattenIndexerCategories(t *testing.T) {
+	t.Parallel()
+
+	ids := []int32{}
+	for _, c := range FlattenIndexerCategories(testIndexerCategories()) {
+		ids = append(ids, c.GetId())
+	}
+
+	assert.Equal(t, []int32{2000, 2030, 2040, 5000, 5070}, ids)
+}
+
+func TestUnknownIndexerCategories(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ids      []int64
+		expected []string
+	}{
+		"main categories": {
+			ids:      []int64{2000, 5000},
+			expected: []string{},
+		},
+		"subcategories": {
+			ids:      []int64{2030, 2040, 5070},
+			expected: []string{},
+		},
+		"unknown subcategories": {
+			ids: []int64{2030, 2045, 5075},
+			expected: []string{
+				"Unknown category ID 2045. The nearest category ID is 2040 (Movies/HD).",
+				"Unknown category ID 5075. The nearest category ID is 5070 (TV/Anime).",
+			},
+		},
+		"empty": {
+			ids:      nil,
+			expected: []string{},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, UnknownIndexerCategories(testIndexerCategories(), test.ids))
+		})
+	}
+}
+
+func TestUnknownIndexerCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		categories []*prowlarr.IndexerCategory
+		id         int64
+		expected   string
+	}{
+		"known": {
+			categories: FlattenIndexerCategories(testIndexerCategories()),
+			id:         2040,
+			expected:   "",
+		},
+		"subcategory": {
+			categories: FlattenIndexerCategories(testIndexerCategories()),
+			id:         2045,
+			expected:   "Unknown category ID 2045. The nearest category ID is 2040 (Movies/HD).",
+		},
+		"main": {
+			categories: FlattenIndexerCategories(testIndexerCategories()),
+			id:         5001,
+			expected:   "Unknown category ID 5001. The nearest category ID is 5000 (TV).",
+		},
+		"empty": {
+			categories: nil,
+			id:         1,
+			expected:   "Unknown category ID 1.",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, UnknownIndexerCategory(test.categories, test.id))
+		})
+	}
+}
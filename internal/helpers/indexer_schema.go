@@ -0,0 +1,146 @@
+package helpers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+const (
+	// DefinitionFileField is the field identifying the Cardigann definition of an indexer.
+	DefinitionFileField = "definitionFile"
+	// BaseURLField is the field containing the URL used to reach an indexer.
+	BaseURLField = "baseUrl"
+)
+
+// IndexerSchemas returns the indexer schemas, fetching them only once per provider.
+func (p *ProviderData) IndexerSchemas(ctx context.Context) ([]*prowlarr.IndexerResource, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.indexerSchemas != nil {
+		return p.indexerSchemas, nil
+	}
+
+	response, _, err := p.Client.IndexerApi.ListIndexerSchema(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	p.indexerSchemas = response
+
+	return response, nil
+}
+
+// FindIndexerSchema returns the schema matching implementation, config contract and definition.
+// Cardigann based schemas share the same implementation, so the definition is used to select among them,
+// while it must be empty for the other schemas.
+func FindIndexerSchema(schemas []*prowlarr.IndexerResource, implementation, configContract, definition string) *prowlarr.IndexerResource {
+	for _, s := range schemas {
+		if s.GetImplementation() == implementation && s.GetConfigContract() == configContract && schemaDefinition(s) == definition {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// schemaDefinition returns the Cardigann definition of the schema, empty if missing.
+func schemaDefinition(schema *prowlarr.IndexerResource) string {
+	for _, f := range schema.GetFields() {
+		if f.GetName() == DefinitionFileField {
+			definition, _ := f.GetValue().(string)
+
+			return definition
+		}
+	}
+
+	return ""
+}
+
+// ContainsIndexerURL checks if the URL is part of the list, ignoring the trailing slash.
+func ContainsIndexerURL(urls []string, url string) bool {
+	for _, u := range urls {
+		if strings.TrimSuffix(u, "/") == strings.TrimSuffix(url, "/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FieldValueAttributes returns the `fields` value attributes accepted for a schema field type.
+// Nil is returned for types which are not checked.
+func FieldValueAttributes(fieldType string) []string {
+	switch fieldType {
+	case "checkbox":
+		return []string{"bool_value"}
+	case "number":
+		return []string{"number_value"}
+	case "password":
+		return []string{"sensitive_value"}
+	case "select":
+		return []string{"number_value", "text_value", "set_value"}
+	case "tag", "tagSelect":
+		return []string{"set_value"}
+	case "textbox", "textArea", "url", "path", "filePath", "hidden", "info", "captcha":
+		return []string{"text_value"}
+	default:
+		return nil
+	}
+}
+
+// DeprecatedFieldValueAttributes returns the `fields` value attributes still accepted for a schema field type,
+// which should be replaced by the ones returned by FieldValueAttributes.
+func DeprecatedFieldValueAttributes(fieldType string) []string {
+	if fieldType == "password" {
+		return []string{"text_value"}
+	}
+
+	return nil
+}
+
+// ClosestMatch returns the candidate nearest to the given name, empty if none is close enough.
+func ClosestMatch(name string, candidates []string) string {
+	var match string
+
+	best := max(2, len(name)/3) + 1
+
+	for _, c := range candidates {
+		if distance := levenshtein(strings.ToLower(name), strings.ToLower(c)); distance < best {
+			best = distance
+			match = c
+		}
+	}
+
+	return match
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	first, second := []rune(a), []rune(b)
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
@@ -0,0 +1,204 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func testIndexerSchema(name, implementation, definition string) *prowlarr.IndexerResource {
+	schema := prowlarr.NewIndexerResource()
+	schema.SetName(name)
+	schema.SetImplementation(implementation)
+	schema.SetConfigContract(implementation + "Settings")
+
+	if definition != "" {
+		field := prowlarr.NewField()
+		field.SetName(DefinitionFileField)
+		field.SetValue(definition)
+		schema.SetFields([]*prowlarr.Field{field})
+	}
+
+	return schema
+}
+
+func TestFindIndexerSchema(t *testing.T) {
+	t.Parallel()
+
+	schemas := []*prowlarr.IndexerResource{
+		testIndexerSchema("Newznab", "Newznab", ""),
+		testIndexerSchema("1337x", "Cardigann", "1337x"),
+		testIndexerSchema("YTS", "Cardigann", "yts"),
+		testIndexerSchema("Lone", "Lone", "lone"),
+	}
+
+	tests := map[string]struct {
+		implementation string
+		definition     string
+		expected       string
+	}{
+		"single": {
+			implementation: "Newznab",
+			expected:       "Newznab",
+		},
+		"definition": {
+			implementation: "Cardigann",
+			definition:     "yts",
+			expected:       "YTS",
+		},
+		"missing definition": {
+			implementation: "Cardigann",
+			definition:     "missing",
+		},
+		"single definition": {
+			implementation: "Lone",
+			definition:     "lone",
+			expected:       "Lone",
+		},
+		"single missing definition": {
+			implementation: "Lone",
+			definition:     "missing",
+		},
+		"unexpected definition": {
+			implementation: "Newznab",
+			definition:     "yts",
+		},
+		"missing": {
+			implementation: "Torznab",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := FindIndexerSchema(schemas, test.implementation, test.implementation+"Settings", test.definition)
+			if test.expected == "" {
+				assert.Nil(t, schema)
+			} else {
+				assert.Equal(t, test.expected, schema.GetName())
+			}
+		})
+	}
+}
+
+func TestContainsIndexerURL(t *testing.T) {
+	t.Parallel()
+
+	urls := []string{"https://1337x.to/", "https://1337x.st"}
+
+	tests := map[string]struct {
+		url      string
+		expected bool
+	}{
+		"exact": {
+			url:      "https://1337x.to/",
+			expected: true,
+		},
+		"missing slash": {
+			url:      "https://1337x.to",
+			expected: true,
+		},
+		"extra slash": {
+			url:      "https://1337x.st/",
+			expected: true,
+		},
+		"missing": {
+			url:      "https://1337x.gd/",
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ContainsIndexerURL(urls, test.url))
+		})
+	}
+}
+
+func TestFieldValueAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected []string
+	}{
+		"checkbox": {expected: []string{"bool_value"}},
+		"password": {expected: []string{"sensitive_value"}},
+		"textbox":  {expected: []string{"text_value"}},
+		"select":   {expected: []string{"number_value", "text_value", "set_value"}},
+		"tagSelect": {
+			expected: []string{"set_value"},
+		},
+		"keyValueList": {expected: nil},
+	}
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, FieldValueAttributes(name))
+		})
+	}
+}
+
+func TestDeprecatedFieldValueAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected []string
+	}{
+		"password": {expected: []string{"text_value"}},
+		"textbox":  {expected: nil},
+		"checkbox": {expected: nil},
+	}
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, DeprecatedFieldValueAttributes(name))
+		})
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"baseUrl", "apiPath", "apiKey", "baseSettings.queryLimit"}
+
+	tests := map[string]struct {
+		expected string
+	}{
+		"apikey":                 {expected: "apiKey"},
+		"baseURL":                {expected: "baseUrl"},
+		"apiPat":                 {expected: "apiPath"},
+		"baseSettings.querylimt": {expected: "baseSettings.queryLimit"},
+		"minimumSeeders":         {expected: ""},
+	}
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ClosestMatch(name, candidates))
+		})
+	}
+}
+
+func TestIndexerSchemasCache(t *testing.T) {
+	t.Parallel()
+
+	data := &ProviderData{Client: testClient(t, 200, `[{"name":"Newznab","implementation":"Newznab"}]`)}
+
+	schemas, err := data.IndexerSchemas(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, "Newznab", schemas[0].GetName())
+
+	cached, _ := data.IndexerSchemas(context.TODO())
+	assert.Same(t, schemas[0], cached[0])
+}
@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const appName = "Prowlarr"
+
+// ProviderData is the data shared by the provider with resources and data sources.
+type ProviderData struct {
+	Client *prowlarr.APIClient
+	// DefaultTags are merged into every taggable resource, nil if not configured.
+	DefaultTags *DefaultTags
+	// TestOnApply is the default for the resources test_on_apply attribute.
+	TestOnApply bool
+	// Version is the connected Prowlarr version, empty if the system status check is disabled.
+	Version string
+	// indexerSchemas caches the indexer schemas, used for plan time validation.
+	indexerSchemas []*prowlarr.IndexerResource
+	// indexerCategories caches the indexer categories, used for plan time validation.
+	indexerCategories []*prowlarr.IndexerCategory
+	mu                sync.Mutex
+}
+
+// CheckMinVersion adds an attribute error if the connected Prowlarr version is older than the required one.
+// No check is performed if the version is unknown.
+func (p *ProviderData) CheckMinVersion(diags *diag.Diagnostics, attrPath path.Path, minVersion string) {
+	if p == nil || p.Version == "" || CompareVersions(p.Version, minVersion) >= 0 {
+		return
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Unsupported Prowlarr Version",
+		fmt.Sprintf("Attribute %s requires Prowlarr %s or newer, connected Prowlarr version is %s", attrPath, minVersion, p.Version),
+	)
+}
+
+// CompareVersions compares two dotted versions (e.g. `1.11.4.4173`) returning -1, 0 or 1.
+// Missing or non numeric segments are considered 0.
+func CompareVersions(a, b string) int {
+	first := strings.Split(a, ".")
+	second := strings.Split(b, ".")
+
+	for i := 0; i < max(len(first), len(second)); i++ {
+		x, y := versionSegment(first, i), versionSegment(second, i)
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionSegment(segments []string, i int) int {
+	if i >= len(segments) {
+		return 0
+	}
+
+	value, _ := strconv.Atoi(segments[i])
+
+	return value
+}
+
+// CheckSystemStatus calls the system status endpoint returning the Prowlarr version
+// and a clear error for authentication issues, unreachable host or wrong application.
+func CheckSystemStatus(ctx context.Context, client *prowlarr.APIClient, url string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	status, httpResp, err := client.SystemApi.GetSystemStatus(ctx).Execute()
+
+	switch {
+	case httpResp == nil && err != nil:
+		diags.AddError(
+			"Prowlarr Unreachable",
+			fmt.Sprintf("Unable to connect to Prowlarr at %s: %s", url, err),
+		)
+	case httpResp.StatusCode == http.StatusUnauthorized || httpResp.StatusCode == http.StatusForbidden:
+		diags.AddError(
+			"Prowlarr Authentication Failed",
+			fmt.Sprintf("Prowlarr at %s rejected the credentials (%s). Check the configured API key or authorization.", url, httpResp.Status),
+		)
+	case httpResp.StatusCode == http.StatusNotFound:
+		diags.AddError(
+			"Prowlarr API Not Found",
+			fmt.Sprintf("No Prowlarr API found at %s. Check that the URL points to Prowlarr and that the URL base is correct.", url),
+		)
+	case err != nil:
+		var body string
+
+		var apiErr *prowlarr.GenericOpenAPIError
+		if errors.As(err, &apiErr) {
+			body = string(apiErr.Body())
+		}
+
+		diags.AddError(
+			"Unexpected Prowlarr Response",
+			fmt.Sprintf("Unable to read system status from %s, got error: %s %s", url, err, body),
+		)
+	case status.GetAppName() != appName:
+		diags.AddError(
+			"Unexpected Application",
+			fmt.Sprintf("The application at %s is %s, not %s. Check the configured URL.", url, status.GetAppName(), appName),
+		)
+	default:
+		return status.GetVersion(), diags
+	}
+
+	return "", diags
+}
@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		first    string
+		second   string
+		expected int
+	}{
+		"equal": {
+			first:    "1.11.4.4173",
+			second:   "1.11.4.4173",
+			expected: 0,
+		},
+		"older": {
+			first:    "1.9.4.4039",
+			second:   "1.11",
+			expected: -1,
+		},
+		"newer": {
+			first:    "1.11.4.4173",
+			second:   "1.11",
+			expected: 1,
+		},
+		"missing segments": {
+			first:    "1.11",
+			second:   "1.11.0.0",
+			expected: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, CompareVersions(test.first, test.second))
+		})
+	}
+}
+
+func TestCheckMinVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		version string
+		err     bool
+	}{
+		"newer": {
+			version: "1.12.0.4188",
+		},
+		"unknown": {
+			version: "",
+		},
+		"older": {
+			version: "1.10.5.4116",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			data := &ProviderData{Version: test.version}
+			data.CheckMinVersion(&diags, path.Root("name"), "1.11")
+			assert.Equal(t, test.err, diags.HasError())
+		})
+	}
+}
+
+func TestCheckSystemStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		body     string
+		expected string
+		summary  string
+	}{
+		"working": {
+			status:   200,
+			body:     `{"appName":"Prowlarr","version":"1.11.4.4173"}`,
+			expected: "1.11.4.4173",
+		},
+		"unauthorized": {
+			status:  401,
+			summary: "Prowlarr Authentication Failed",
+		},
+		"not found": {
+			status:  404,
+			summary: "Prowlarr API Not Found",
+		},
+		"wrong app": {
+			status:  200,
+			body:    `{"appName":"Lidarr","version":"2.0.7.3849"}`,
+			summary: "Unexpected Application",
+		},
+		"html": {
+			status:  200,
+			body:    `<html></html>`,
+			summary: "Unexpected Prowlarr Response",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			version, diags := CheckSystemStatus(context.TODO(), testClient(t, test.status, test.body), "http://prowlarr")
+			assert.Equal(t, test.expected, version)
+
+			if test.summary == "" {
+				assert.False(t, diags.HasError())
+			} else {
+				assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+			}
+		})
+	}
+
+	config := prowlarr.NewConfiguration()
+	config.Servers[0].URL = "http://127.0.0.1:1"
+	_, diags := CheckSystemStatus(context.TODO(), prowlarr.NewAPIClient(config), "http://127.0.0.1:1")
+	assert.Equal(t, "Prowlarr Unreachable", diags.Errors()[0].Summary())
+}
@@ -0,0 +1,257 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// settingsListSeparator separates the elements of list settings (e.g. `1,5`).
+const settingsListSeparator = ","
+
+// isListField checks if the schema field holds a list value.
+func isListField(field *prowlarr.Field) bool {
+	if field.GetType() == "tag" || field.GetType() == "tagSelect" {
+		return true
+	}
+
+	_, ok := field.GetValue().([]interface{})
+
+	return ok
+}
+
+// SettingToFieldValue converts a string setting into the value type of the schema field.
+func SettingToFieldValue(value string, field *prowlarr.Field) (interface{}, error) {
+	switch {
+	case isListField(field):
+		list := make([]interface{}, 0)
+
+		for _, element := range strings.Split(value, settingsListSeparator) {
+			if element = strings.TrimSpace(element); element == "" {
+				continue
+			}
+
+			if number, err := strconv.ParseInt(element, 10, 64); err == nil {
+				list = append(list, number)
+			} else {
+				list = append(list, element)
+			}
+		}
+
+		return list, nil
+	case field.GetType() == "checkbox":
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("setting '%s' must be a bool, got '%s'", field.GetName(), value)
+		}
+
+		return boolValue, nil
+	case field.GetType() == "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("setting '%s' must be a number, got '%s'", field.GetName(), value)
+		}
+
+		return number, nil
+	case field.GetType() == "select":
+		if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return number, nil
+		}
+
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// SettingFromFieldValue converts an API field value into a string setting.
+// The prior value is kept if the API returns a masked secret or if it converts to the returned value
+// (e.g. `True` for a bool, `10.0` for a number or the same list in a different order).
+func SettingFromFieldValue(field *prowlarr.Field, prior types.String) types.String {
+	value := field.GetValue()
+	if value == nil {
+		return types.StringNull()
+	}
+
+	setting := types.StringValue(formatSetting(value))
+
+	if prior.IsNull() || prior.IsUnknown() {
+		return setting
+	}
+
+	if setting.ValueString() == MaskedValue {
+		return prior
+	}
+
+	if priorValue, err := SettingToFieldValue(prior.ValueString(), field); err == nil && sameSetting(priorValue, value) {
+		return prior
+	}
+
+	return setting
+}
+
+// formatSetting returns the string representation of an API field value, lists are comma separated.
+func formatSetting(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []interface{}:
+		elements := make([]string, len(v))
+		for i, element := range v {
+			elements[i] = formatSetting(element)
+		}
+
+		return strings.Join(elements, settingsListSeparator)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// sameSetting checks if two field values have the same string representation, lists regardless of order.
+func sameSetting(a, b interface{}) bool {
+	first, firstList := a.([]interface{})
+	second, secondList := b.([]interface{})
+
+	if !firstList || !secondList {
+		return formatSetting(a) == formatSetting(b)
+	}
+
+	firstElements := make([]string, len(first))
+	for i, element := range first {
+		firstElements[i] = formatSetting(element)
+	}
+
+	secondElements := make([]string, len(second))
+	for i, element := range second {
+		secondElements[i] = formatSetting(element)
+	}
+
+	return sameElements(firstElements, secondElements)
+}
+
+// sameElements checks if two slices contain the same elements regardless of order.
+func sameElements(a, b []string) bool {
+	first, second := slices.Clone(a), slices.Clone(b)
+	slices.Sort(first)
+	slices.Sort(second)
+
+	return slices.Equal(first, second)
+}
+
+// ReadSettings converts the settings into prowlarr fields using the schema field types.
+func ReadSettings(ctx context.Context, settings types.Map, schemaFields []*prowlarr.Field, diags *diag.Diagnostics) []*prowlarr.Field {
+	if settings.IsNull() || settings.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]types.String, len(settings.Elements()))
+	diags.Append(settings.ElementsAs(ctx, &values, true)...)
+
+	fields := make([]*prowlarr.Field, 0, len(values))
+
+	for _, schemaField := range schemaFields {
+		value, ok := values[schemaField.GetName()]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		fieldValue, err := SettingToFieldValue(value.ValueString(), schemaField)
+		if err != nil {
+			diags.AddError("Invalid Setting", err.Error())
+
+			continue
+		}
+
+		fields = append(fields, setField(schemaField.GetName(), fieldValue))
+	}
+
+	return fields
+}
+
+// WriteSettings converts the prowlarr fields into settings, keeping only the keys already present.
+// The prior keys not returned by the API are kept as they are.
+func WriteSettings(ctx context.Context, fields []*prowlarr.Field, prior types.Map, diags *diag.Diagnostics) types.Map {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+
+	values := make(map[string]types.String, len(prior.Elements()))
+	diags.Append(prior.ElementsAs(ctx, &values, true)...)
+
+	for _, f := range fields {
+		if priorValue, ok := values[f.GetName()]; ok {
+			values[f.GetName()] = SettingFromFieldValue(f, priorValue)
+		}
+	}
+
+	settings, localDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(localDiags...)
+
+	return settings
+}
+
+// ValidateSettings checks that all the settings are defined in the schema, suggesting the closest name.
+func ValidateSettings(settings types.Map, schemaFields []*prowlarr.Field) []string {
+	if settings.IsNull() || settings.IsUnknown() {
+		return nil
+	}
+
+	names := make([]string, len(schemaFields))
+	fieldsByName := make(map[string]*prowlarr.Field, len(schemaFields))
+
+	for i, f := range schemaFields {
+		names[i] = f.GetName()
+		fieldsByName[f.GetName()] = f
+	}
+
+	var errors []string
+
+	for name, value := range settings.Elements() {
+		field, ok := fieldsByName[name]
+		if !ok {
+			message := fmt.Sprintf("setting '%s' is not defined.", name)
+			if match := ClosestMatch(name, names); match != "" {
+				message += fmt.Sprintf(" Did you mean '%s'?", match)
+			}
+
+			errors = append(errors, message)
+
+			continue
+		}
+
+		if stringValue, ok := value.(types.String); ok && !stringValue.IsNull() && !stringValue.IsUnknown() {
+			if _, err := SettingToFieldValue(stringValue.ValueString(), field); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+
+	slices.Sort(errors)
+
+	return errors
+}
+
+// DuplicateSettings returns the sorted names set in both the settings and the sensitive settings.
+func DuplicateSettings(settings, sensitiveSettings types.Map) []string {
+	var names []string
+
+	for name := range settings.Elements() {
+		if _, ok := sensitiveSettings.Elements()[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
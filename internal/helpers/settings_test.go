@@ -0,0 +1,297 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSchemaField(name, fieldType string, value interface{}) *prowlarr.Field {
+	field := prowlarr.NewField()
+	field.SetName(name)
+	field.SetType(fieldType)
+
+	if value != nil {
+		field.SetValue(value)
+	}
+
+	return field
+}
+
+func TestSettingToFieldValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field    *prowlarr.Field
+		value    string
+		expected interface{}
+		err      bool
+	}{
+		"string": {
+			field:    testSchemaField("baseUrl", "textbox", nil),
+			value:    "https://example.com",
+			expected: "https://example.com",
+		},
+		"bool": {
+			field:    testSchemaField("freeleech", "checkbox", nil),
+			value:    "true",
+			expected: true,
+		},
+		"invalid bool": {
+			field: testSchemaField("freeleech", "checkbox", nil),
+			value: "yes",
+			err:   true,
+		},
+		"number": {
+			field:    testSchemaField("torrentBaseSettings.seedRatio", "number", nil),
+			value:    "0.5",
+			expected: 0.5,
+		},
+		"invalid number": {
+			field: testSchemaField("torrentBaseSettings.seedRatio", "number", nil),
+			value: "half",
+			err:   true,
+		},
+		"select": {
+			field:    testSchemaField("sort", "select", nil),
+			value:    "2",
+			expected: int64(2),
+		},
+		"select string": {
+			field:    testSchemaField("sort", "select", nil),
+			value:    "created",
+			expected: "created",
+		},
+		"list": {
+			field:    testSchemaField("codecs", "select", []interface{}{}),
+			value:    "1, 5",
+			expected: []interface{}{int64(1), int64(5)},
+		},
+		"empty list": {
+			field:    testSchemaField("codecs", "tagSelect", nil),
+			value:    "",
+			expected: []interface{}{},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := SettingToFieldValue(test.value, test.field)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestSettingFromFieldValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field    *prowlarr.Field
+		prior    types.String
+		expected types.String
+	}{
+		"nil": {
+			field:    testSchemaField("baseUrl", "textbox", nil),
+			prior:    types.StringValue("test"),
+			expected: types.StringNull(),
+		},
+		"bool": {
+			field:    testSchemaField("enabled", "checkbox", true),
+			prior:    types.StringNull(),
+			expected: types.StringValue("true"),
+		},
+		"bool capitalized": {
+			field:    testSchemaField("enabled", "checkbox", true),
+			prior:    types.StringValue("True"),
+			expected: types.StringValue("True"),
+		},
+		"bool numeric": {
+			field:    testSchemaField("enabled", "checkbox", true),
+			prior:    types.StringValue("1"),
+			expected: types.StringValue("1"),
+		},
+		"bool changed": {
+			field:    testSchemaField("enabled", "checkbox", false),
+			prior:    types.StringValue("1"),
+			expected: types.StringValue("false"),
+		},
+		"number": {
+			field:    testSchemaField("minimumSeeders", "number", float64(5)),
+			prior:    types.StringNull(),
+			expected: types.StringValue("5"),
+		},
+		"number decimal": {
+			field:    testSchemaField("seedRatio", "number", float64(10)),
+			prior:    types.StringValue("10.0"),
+			expected: types.StringValue("10.0"),
+		},
+		"number changed": {
+			field:    testSchemaField("seedRatio", "number", float64(10)),
+			prior:    types.StringValue("1.5"),
+			expected: types.StringValue("10"),
+		},
+		"list": {
+			field:    testSchemaField("categories", "select", []interface{}{float64(1), float64(5)}),
+			prior:    types.StringNull(),
+			expected: types.StringValue("1,5"),
+		},
+		"list same elements": {
+			field:    testSchemaField("categories", "select", []interface{}{float64(1), float64(5)}),
+			prior:    types.StringValue("5, 1"),
+			expected: types.StringValue("5, 1"),
+		},
+		"list changed": {
+			field:    testSchemaField("categories", "select", []interface{}{float64(1)}),
+			prior:    types.StringValue("5,1"),
+			expected: types.StringValue("1"),
+		},
+		"string list spaces": {
+			field:    testSchemaField("codecs", "tag", []interface{}{"a", "b"}),
+			prior:    types.StringValue(" a, b"),
+			expected: types.StringValue(" a, b"),
+		},
+		"masked": {
+			field:    testSchemaField("apiKey", "password", MaskedValue),
+			prior:    types.StringValue("secret"),
+			expected: types.StringValue("secret"),
+		},
+		"text changed": {
+			field:    testSchemaField("baseUrl", "textbox", "https://new.example.com"),
+			prior:    types.StringValue("https://example.com"),
+			expected: types.StringValue("https://new.example.com"),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, SettingFromFieldValue(test.field, test.prior))
+		})
+	}
+}
+
+func TestReadWriteSettings(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	schemaFields := []*prowlarr.Field{
+		testSchemaField("baseUrl", "textbox", nil),
+		testSchemaField("minimumSeeders", "number", nil),
+		testSchemaField("apiKey", "password", nil),
+	}
+	settings := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"baseUrl":        types.StringValue("https://example.com"),
+		"minimumSeeders": types.StringValue("1"),
+	})
+
+	fields := ReadSettings(context.TODO(), settings, schemaFields, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []*prowlarr.Field{setField("baseUrl", "https://example.com"), setField("minimumSeeders", float64(1))}, fields)
+
+	// API returns also unmanaged fields
+	fields = append(fields, setField("apiKey", MaskedValue))
+	written := WriteSettings(context.TODO(), fields, settings, &diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, settings.Equal(written))
+
+	assert.True(t, WriteSettings(context.TODO(), fields, types.MapNull(types.StringType), &diags).IsNull())
+}
+
+func TestWriteSettingsKeepPrior(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	settings := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"enabled":   types.StringValue("True"),
+		"seedRatio": types.StringValue("10.0"),
+		"codecs":    types.StringValue(" a, b"),
+		"missing":   types.StringValue("value"),
+	})
+	fields := []*prowlarr.Field{
+		testSchemaField("enabled", "checkbox", true),
+		testSchemaField("seedRatio", "number", float64(10)),
+		testSchemaField("codecs", "tag", []interface{}{"b", "a"}),
+	}
+
+	written := WriteSettings(context.TODO(), fields, settings, &diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, settings.Equal(written))
+}
+
+func TestValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	schemaFields := []*prowlarr.Field{
+		testSchemaField("baseUrl", "textbox", nil),
+		testSchemaField("minimumSeeders", "number", nil),
+	}
+	settings := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"baseURL":        types.StringValue("https://example.com"),
+		"minimumSeeders": types.StringValue("one"),
+		"unknown":        types.StringUnknown(),
+	})
+
+	assert.Equal(t, []string{
+		"setting 'baseURL' is not defined. Did you mean 'baseUrl'?",
+		"setting 'minimumSeeders' must be a number, got 'one'",
+		"setting 'unknown' is not defined.",
+	}, ValidateSettings(settings, schemaFields))
+	assert.Nil(t, ValidateSettings(types.MapNull(types.StringType), schemaFields))
+}
+
+func TestDuplicateSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		settings          types.Map
+		sensitiveSettings types.Map
+		expected          []string
+	}{
+		"duplicates": {
+			settings: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"baseUrl": types.StringValue("https://example.com"),
+				"apiKey":  types.StringValue("key"),
+				"user":    types.StringValue("user"),
+			}),
+			sensitiveSettings: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"user":   types.StringValue("user"),
+				"apiKey": types.StringValue("secret"),
+			}),
+			expected: []string{"apiKey", "user"},
+		},
+		"distinct": {
+			settings: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"baseUrl": types.StringValue("https://example.com"),
+			}),
+			sensitiveSettings: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"apiKey": types.StringValue("secret"),
+			}),
+			expected: nil,
+		},
+		"null": {
+			settings:          types.MapNull(types.StringType),
+			sensitiveSettings: types.MapNull(types.StringType),
+			expected:          nil,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, DuplicateSettings(test.settings, test.sensitiveSettings))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ImportStatePassthroughIntID is a helper function to set the import
@@ -36,41 +37,75 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 }
 
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func ResourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *prowlarr.APIClient {
+func ResourceConfigure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *prowlarr.APIClient {
+	if data := ResourceProviderData(ctx, req, resp); data != nil {
+		return data.Client
+	}
+
+	return nil
+}
+
+// ResourceProviderData is a helper function to get the provider data for a specific resource.
+func ResourceProviderData(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *ProviderData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return nil
 	}
 
-	client, ok := req.ProviderData.(*prowlarr.APIClient)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			UnexpectedResourceConfigureType,
-			fmt.Sprintf("Expected *prowlarr.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return nil
 	}
 
-	return client
+	return data
 }
 
 // DataSourceConfigure is a helper function to set the client for a specific data source.
-func DataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *prowlarr.APIClient {
+func DataSourceConfigure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *prowlarr.APIClient {
+	if data := DataSourceProviderData(ctx, req, resp); data != nil {
+		return data.Client
+	}
+
+	return nil
+}
+
+// DataSourceProviderData is a helper function to get the provider data for a specific data source.
+func DataSourceProviderData(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *ProviderData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return nil
 	}
 
-	client, ok := req.ProviderData.(*prowlarr.APIClient)
+	data, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			UnexpectedDataSourceConfigureType,
-			fmt.Sprintf("Expected *prowlarr.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *helpers.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return nil
 	}
 
-	return client
+	return data
+}
+
+// Descriptions of the attributes shared by the resources.
+const (
+	ForceSaveDescription   = "Force save flag. If Prowlarr validation fails only with warnings (e.g. the remote host is not reachable yet), the request is submitted again ignoring them and the warnings are reported."
+	TestOnApplyDescription = "Test the configuration after create and update, reporting the test failures as errors. Defaults to the provider `test_on_apply`."
+)
+
+// IsTestOnApply checks if the configuration must be tested after create and update.
+// The resource attribute takes precedence over the provider default.
+func IsTestOnApply(testOnApply types.Bool, providerDefault bool) bool {
+	if testOnApply.IsNull() || testOnApply.IsUnknown() {
+		return providerDefault
+	}
+
+	return testOnApply.ValueBool()
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,26 +17,31 @@ func TestDataSourceConfigure(t *testing.T) {
 
 	var diags diag.Diagnostics
 
-	diags.AddError("Unexpected DataSource Configure Type", "Expected *prowlarr.APIClient, got: string. Please report this issue to the provider developers.")
+	diags.AddError("Unexpected DataSource Configure Type", "Expected *helpers.ProviderData, got: string. Please report this issue to the provider developers.")
+
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
 
 	tests := map[string]struct {
-		expected    any
-		errorString diag.Diagnostics
+		providerData any
+		expected     *prowlarr.APIClient
+		errorString  diag.Diagnostics
 	}{
 		"working": {
-			expected: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+			providerData: &ProviderData{Client: client},
+			expected:     client,
 		},
 		"nil": {
-			expected: (*prowlarr.APIClient)(nil),
+			providerData: nil,
+			expected:     (*prowlarr.APIClient)(nil),
 		},
 		"error": {
-			expected:    "abc",
-			errorString: diags,
+			providerData: "abc",
+			errorString:  diags,
 		},
 	}
 	for name, test := range tests {
 		test := test
-		req := datasource.ConfigureRequest{ProviderData: test.expected}
+		req := datasource.ConfigureRequest{ProviderData: test.providerData}
 		resp := datasource.ConfigureResponse{}
 
 		t.Run(name, func(t *testing.T) {
@@ -54,26 +60,31 @@ func TestResourceConfigure(t *testing.T) {
 
 	var diags diag.Diagnostics
 
-	diags.AddError("Unexpected Resource Configure Type", "Expected *prowlarr.APIClient, got: string. Please report this issue to the provider developers.")
+	diags.AddError("Unexpected Resource Configure Type", "Expected *helpers.ProviderData, got: string. Please report this issue to the provider developers.")
+
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
 
 	tests := map[string]struct {
-		expected    any
-		errorString diag.Diagnostics
+		providerData any
+		expected     *prowlarr.APIClient
+		errorString  diag.Diagnostics
 	}{
 		"working": {
-			expected: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+			providerData: &ProviderData{Client: client},
+			expected:     client,
 		},
 		"nil": {
-			expected: (*prowlarr.APIClient)(nil),
+			providerData: nil,
+			expected:     (*prowlarr.APIClient)(nil),
 		},
 		"error": {
-			expected:    "abc",
-			errorString: diags,
+			providerData: "abc",
+			errorString:  diags,
 		},
 	}
 	for name, test := range tests {
 		test := test
-		req := resource.ConfigureRequest{ProviderData: test.expected}
+		req := resource.ConfigureRequest{ProviderData: test.providerData}
 		resp := resource.ConfigureResponse{}
 
 		t.Run(name, func(t *testing.T) {
@@ -86,3 +97,38 @@ func TestResourceConfigure(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTestOnApply(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		testOnApply     types.Bool
+		providerDefault bool
+		expected        bool
+	}{
+		"null default false": {
+			testOnApply: types.BoolNull(),
+		},
+		"null default true": {
+			testOnApply:     types.BoolNull(),
+			providerDefault: true,
+			expected:        true,
+		},
+		"true default false": {
+			testOnApply: types.BoolValue(true),
+			expected:    true,
+		},
+		"false default true": {
+			testOnApply:     types.BoolValue(false),
+			providerDefault: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsTestOnApply(test.testOnApply, test.providerDefault))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSOptions contains the TLS settings of the provider HTTP client.
+type TLSOptions struct {
+	CACertificate      string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// IsEmpty returns true if no TLS option is set.
+func (o TLSOptions) IsEmpty() bool {
+	return o.CACertificate == "" && o.ClientCertificate == "" && o.ClientKey == "" && !o.InsecureSkipVerify
+}
+
+// TLSConfig builds the TLS configuration from PEM encoded certificates and key.
+func (o TLSOptions) TLSConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if o.CACertificate != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(o.CACertificate)) {
+			return nil, errors.New("no valid PEM certificate found in CA certificate")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (o.ClientCertificate == "") != (o.ClientKey == "") {
+		return nil, errors.New("client certificate and client key must be provided together")
+	}
+
+	if o.ClientCertificate != "" {
+		certificate, err := tls.X509KeyPair([]byte(o.ClientCertificate), []byte(o.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// NewHTTPTransport returns a copy of the default HTTP transport using the given TLS options.
+func NewHTTPTransport(options TLSOptions) (*http.Transport, error) {
+	transport, _ := http.DefaultTransport.(*http.Transport)
+	transport = transport.Clone()
+
+	if options.IsEmpty() {
+		return transport, nil
+	}
+
+	config, err := options.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = config
+
+	return transport, nil
+}
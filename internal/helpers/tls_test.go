@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testClientCertificate generates a self signed client certificate and key in PEM format.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := testClientCertificate(t)
+	clientPool := x509.NewCertPool()
+	clientPool.AppendCertsFromPEM([]byte(clientCert))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		ClientCAs:  clientPool,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	mtlsServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	mtlsServer.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientPool,
+	}
+	mtlsServer.StartTLS()
+	t.Cleanup(mtlsServer.Close)
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := map[string]struct {
+		options TLSOptions
+		url     string
+		success bool
+	}{
+		"untrusted": {
+			options: TLSOptions{},
+			url:     server.URL,
+			success: false,
+		},
+		"ca": {
+			options: TLSOptions{CACertificate: caCert},
+			url:     server.URL,
+			success: true,
+		},
+		"insecure": {
+			options: TLSOptions{InsecureSkipVerify: true},
+			url:     server.URL,
+			success: true,
+		},
+		"missing client certificate": {
+			options: TLSOptions{CACertificate: caCert},
+			url:     mtlsServer.URL,
+			success: false,
+		},
+		"client certificate": {
+			options: TLSOptions{CACertificate: caCert, ClientCertificate: clientCert, ClientKey: clientKey},
+			url:     mtlsServer.URL,
+			success: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			transport, err := NewHTTPTransport(test.options)
+			assert.Nil(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Get(test.url)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			assert.Equal(t, test.success, err == nil)
+		})
+	}
+}
+
+func TestTLSConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := testClientCertificate(t)
+
+	tests := map[string]TLSOptions{
+		"invalid ca":       {CACertificate: "not a certificate"},
+		"missing key":      {ClientCertificate: clientCert},
+		"missing cert":     {ClientKey: clientKey},
+		"mismatching pair": {ClientCertificate: clientCert, ClientKey: "not a key"},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewHTTPTransport(test)
+			assert.NotNil(t, err)
+		})
+	}
+}
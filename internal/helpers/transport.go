@@ -0,0 +1,264 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+type contextKey string
+
+const (
+	forceSaveKey  contextKey = "forceSave"
+	bodyFieldsKey contextKey = "bodyFields"
+)
+
+// idempotentMethods are the HTTP methods which can be safely retried.
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+// ContextWithForceSave returns a context which makes the API call skip validation warnings.
+// It is needed for create calls, since the SDK exposes the forceSave parameter only for updates.
+func ContextWithForceSave(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceSaveKey, true)
+}
+
+// bodyFields are the fields added to the JSON request body of the API calls to path.
+type bodyFields struct {
+	fields map[string]any
+	path   string
+}
+
+// ContextWithBodyFields returns a context which makes the API calls to the given path (e.g. `/api/v1/indexer`
+// and its sub paths) add the given fields to the JSON request body, without overriding the ones set by the SDK.
+// It is a temporary workaround for the API fields not yet part of the prowlarr-go SDK models
+// (e.g. the indexer downloadClientId), to be removed once the SDK models include them.
+func ContextWithBodyFields(ctx context.Context, path string, fields map[string]any) context.Context {
+	return context.WithValue(ctx, bodyFieldsKey, bodyFields{fields: fields, path: path})
+}
+
+// DecodeBody decodes the JSON response body into value, restoring it to be read again.
+// It is needed for the API fields not yet part of the SDK models, like ContextWithBodyFields.
+func DecodeBody(resp *http.Response, value any) error {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	return json.Unmarshal(body, value)
+}
+
+// DecodeBodyField decodes the given field of the JSON response body into value.
+// It returns false if the field is not part of the response.
+func DecodeBodyField(resp *http.Response, name string, value any) (bool, error) {
+	var fields map[string]json.RawMessage
+	if err := DecodeBody(resp, &fields); err != nil {
+		return false, err
+	}
+
+	raw, ok := fields[name]
+	if !ok {
+		return false, nil
+	}
+
+	return true, json.Unmarshal(raw, value)
+}
+
+// Transport is the provider HTTP transport, wrapping the SDK requests.
+type Transport struct {
+	Base         http.RoundTripper
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	MaxRetries   int
+}
+
+// NewTransport returns a new transport based on the given one.
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{Base: base}
+}
+
+// RoundTrip adds the forceSave query parameter and the body fields when requested in context
+// and retries idempotent requests on transient errors with exponential backoff.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if force, ok := req.Context().Value(forceSaveKey).(bool); ok && force {
+		req = req.Clone(req.Context())
+		query := req.URL.Query()
+		query.Set("forceSave", "true")
+		req.URL.RawQuery = query.Encode()
+	}
+
+	if body, ok := req.Context().Value(bodyFieldsKey).(bodyFields); ok && len(body.fields) > 0 && req.Body != nil && matchesPath(req.URL.Path, body.path) {
+		var err error
+		if req, err = addBodyFields(req, body.fields); err != nil {
+			return nil, err
+		}
+	}
+
+	retries := t.MaxRetries
+	if !slices.Contains(idempotentMethods, req.Method) || (req.Body != nil && req.GetBody == nil) {
+		retries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(req)
+		if attempt >= retries || !isRetryable(resp, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(Backoff(attempt, t.RetryWaitMin, t.RetryWaitMax)):
+		}
+	}
+}
+
+// matchesPath checks if the URL path is the API path or one of its sub paths, regardless of the URL base.
+func matchesPath(urlPath, apiPath string) bool {
+	index := strings.Index(urlPath, apiPath)
+	if index < 0 {
+		return false
+	}
+
+	rest := urlPath[index+len(apiPath):]
+
+	return rest == "" || strings.HasPrefix(rest, "/")
+}
+
+// addBodyFields returns a copy of the request with the given fields added to the JSON body.
+// The fields already in the body are not overridden and a body which is not a JSON object is sent unchanged.
+func addBodyFields(req *http.Request, fields map[string]any) (*http.Request, error) {
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(body, &object); err == nil && object != nil {
+		for k, v := range fields {
+			if _, ok := object[k]; !ok {
+				object[k] = v
+			}
+		}
+
+		if body, err = json.Marshal(object); err != nil {
+			return nil, err
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return req, nil
+}
+
+// isRetryable checks if the response is caused by a transient error.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	return isRetryableStatus(resp.StatusCode)
+}
+
+// isTransientError checks if the request failed because of a transient network error.
+// The permanent ones (e.g. TLS verification, unknown host or connection refused) are not retried,
+// to report a misconfigured provider without delay.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isRetryableStatus checks if the status code is returned while Prowlarr is starting or overloaded.
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || (status >= http.StatusInternalServerError && status != http.StatusNotImplemented)
+}
+
+// Backoff returns the exponential wait time for the given attempt, capped to max.
+func Backoff(attempt int, minWait, maxWait time.Duration) time.Duration {
+	wait := minWait
+	for i := 0; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxWait)
+}
+
+// WaitForReady polls Prowlarr ping endpoint until it is ready or the timeout expires.
+func WaitForReady(ctx context.Context, client *prowlarr.APIClient, timeout, minWait, maxWait time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		_, httpResp, err := client.PingApi.GetPing(ctx).Execute()
+		if err == nil {
+			return nil
+		}
+
+		// a response different from a transient one means that the service is up.
+		if httpResp != nil && !isRetryableStatus(httpResp.StatusCode) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("prowlarr not ready after %s: %w", timeout, err)
+		case <-time.After(Backoff(attempt, minWait, maxWait)):
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportForceSave(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"force": {
+			ctx:      ContextWithForceSave(context.TODO()),
+			expected: "true",
+		},
+		"default": {
+			ctx:      context.TODO(),
+			expected: "",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var forceSave string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				forceSave = r.URL.Query().Get("forceSave")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequestWithContext(test.ctx, http.MethodPost, server.URL+"/api/v1/applications?id=1", nil)
+			resp, err := (&http.Client{Transport: NewTransport(nil)}).Do(req)
+			assert.Nil(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.expected, forceSave)
+		})
+	}
+}
+
+func TestTransportBodyFields(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]any{"downloadClientId": 2}
+
+	tests := map[string]struct {
+		ctx      context.Context
+		method   string
+		path     string
+		body     string
+		expected string
+		failures int
+	}{
+		"create": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", fields),
+			method:   http.MethodPost,
+			path:     "/api/v1/indexer",
+			body:     `{"name":"test"}`,
+			expected: `{"downloadClientId":2,"name":"test"}`,
+		},
+		"update": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", fields),
+			method:   http.MethodPut,
+			path:     "/prowlarr/api/v1/indexer/1",
+			body:     `{"id":1,"name":"test"}`,
+			expected: `{"downloadClientId":2,"id":1,"name":"test"}`,
+		},
+		"retry replay": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", fields),
+			method:   http.MethodPut,
+			path:     "/api/v1/indexer/1",
+			body:     `{"id":1,"name":"test"}`,
+			expected: `{"downloadClientId":2,"id":1,"name":"test"}`,
+			failures: 2,
+		},
+		"existing field": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", map[string]any{"name": "other"}),
+			method:   http.MethodPost,
+			path:     "/api/v1/indexer",
+			body:     `{"name":"test"}`,
+			expected: `{"name":"test"}`,
+		},
+		"other path": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", fields),
+			method:   http.MethodPost,
+			path:     "/api/v1/indexerproxy",
+			body:     `{"name":"test"}`,
+			expected: `{"name":"test"}`,
+		},
+		"not an object": {
+			ctx:      ContextWithBodyFields(context.TODO(), "/api/v1/indexer", fields),
+			method:   http.MethodPost,
+			path:     "/api/v1/indexer",
+			body:     `[{"name":"test"}]`,
+			expected: `[{"name":"test"}]`,
+		},
+		"default": {
+			ctx:      context.TODO(),
+			method:   http.MethodPost,
+			path:     "/api/v1/indexer",
+			body:     `{"name":"test"}`,
+			expected: `{"name":"test"}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				bodies []string
+				mu     sync.Mutex
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+
+				if len(bodies) <= test.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			transport := NewTransport(nil)
+			transport.MaxRetries = test.failures
+			transport.RetryWaitMin = time.Millisecond
+			transport.RetryWaitMax = time.Millisecond
+
+			req, _ := http.NewRequestWithContext(test.ctx, test.method, server.URL+test.path, bytes.NewBufferString(test.body))
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			assert.Nil(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Len(t, bodies, test.failures+1)
+
+			for _, body := range bodies {
+				assert.JSONEq(t, test.expected, body)
+			}
+		})
+	}
+}
+
+func TestDecodeBodyField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected int64
+		found    bool
+		err      bool
+	}{
+		"found": {
+			body:     `{"id":1,"downloadClientId":3}`,
+			expected: 3,
+			found:    true,
+		},
+		"missing": {
+			body: `{"id":1}`,
+		},
+		"invalid": {
+			body: `[]`,
+			err:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var value int64
+
+			resp := &http.Response{Body: io.NopCloser(bytes.NewBufferString(test.body))}
+			found, err := DecodeBodyField(resp, "downloadClientId", &value)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+
+			// the body can be read again.
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, test.body, string(body))
+		})
+	}
+}
+
+func TestTransportRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		status   int
+		failures int
+		expected int
+		calls    int
+	}{
+		"get recovered": {
+			method:   http.MethodGet,
+			status:   http.StatusServiceUnavailable,
+			failures: 2,
+			expected: http.StatusOK,
+			calls:    3,
+		},
+		"get exhausted": {
+			method:   http.MethodGet,
+			status:   http.StatusBadGateway,
+			failures: 5,
+			expected: http.StatusBadGateway,
+			calls:    4,
+		},
+		"put recovered": {
+			method:   http.MethodPut,
+			status:   http.StatusInternalServerError,
+			failures: 1,
+			expected: http.StatusOK,
+			calls:    2,
+		},
+		"post not retried": {
+			method:   http.MethodPost,
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			expected: http.StatusServiceUnavailable,
+			calls:    1,
+		},
+		"client error not retried": {
+			method:   http.MethodGet,
+			status:   http.StatusUnauthorized,
+			failures: 1,
+			expected: http.StatusUnauthorized,
+			calls:    1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				calls int
+				mu    sync.Mutex
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "body", string(body))
+
+				calls++
+				if calls <= test.failures {
+					w.WriteHeader(test.status)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			transport := NewTransport(nil)
+			transport.MaxRetries = 3
+			transport.RetryWaitMin = time.Millisecond
+			transport.RetryWaitMax = 5 * time.Millisecond
+
+			req, _ := http.NewRequest(test.method, server.URL, bytes.NewBufferString("body"))
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			assert.Nil(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err       error
+		transient bool
+	}{
+		"connection reset": {
+			err:       &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}},
+			transient: true,
+		},
+		"unexpected eof": {
+			err:       &url.Error{Op: "Get", URL: "http://localhost", Err: io.ErrUnexpectedEOF},
+			transient: true,
+		},
+		"timeout": {
+			err:       &url.Error{Op: "Get", URL: "http://localhost", Err: &net.DNSError{Err: "timeout", IsTimeout: true}},
+			transient: true,
+		},
+		"connection refused": {
+			err:       &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}},
+			transient: false,
+		},
+		"unknown host": {
+			err:       &url.Error{Op: "Get", URL: "http://unknown", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "unknown", IsNotFound: true}}},
+			transient: false,
+		},
+		"tls verification": {
+			err:       &url.Error{Op: "Get", URL: "https://localhost", Err: &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}},
+			transient: false,
+		},
+		"canceled": {
+			err:       &url.Error{Op: "Get", URL: "http://localhost", Err: context.Canceled},
+			transient: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.transient, isTransientError(test.err))
+		})
+	}
+}
+
+func TestTransportRetryConnectionRefused(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	serverURL := server.URL
+	server.Close()
+
+	transport := NewTransport(nil)
+	transport.MaxRetries = 3
+	transport.RetryWaitMin = time.Second
+	transport.RetryWaitMax = time.Second
+
+	start := time.Now()
+	_, err := (&http.Client{Transport: transport}).Get(serverURL)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attempt  int
+		expected time.Duration
+	}{
+		"first": {
+			attempt:  0,
+			expected: time.Second,
+		},
+		"third": {
+			attempt:  2,
+			expected: 4 * time.Second,
+		},
+		"capped": {
+			attempt:  10,
+			expected: 30 * time.Second,
+		},
+		"overflow": {
+			attempt:  100,
+			expected: 30 * time.Second,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, Backoff(test.attempt, time.Second, 30*time.Second))
+		})
+	}
+}
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		failures int
+		timeout  time.Duration
+		ready    bool
+	}{
+		"ready": {
+			failures: 2,
+			timeout:  time.Second,
+			ready:    true,
+		},
+		"timeout": {
+			failures: 1000,
+			timeout:  50 * time.Millisecond,
+			ready:    false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				calls int
+				mu    sync.Mutex
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				calls++
+				if calls <= test.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"OK"}`))
+			}))
+			defer server.Close()
+
+			config := prowlarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			config.HTTPClient = &http.Client{Transport: NewTransport(nil)}
+
+			err := WaitForReady(context.TODO(), prowlarr.NewAPIClient(config), test.timeout, time.Millisecond, 5*time.Millisecond)
+			assert.Equal(t, test.ready, err == nil)
+		})
+	}
+}
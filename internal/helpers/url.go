@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NormalizeURL validates the Prowlarr URL and joins it with the optional URL base,
+// so that the SDK paths (e.g. `/api/v1/indexer`) are appended to it.
+func NormalizeURL(rawURL, urlBase string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("invalid URL scheme '%s', must be http or https", parsed.Scheme)
+	}
+
+	if parsed.Host == "" {
+		return "", errors.New("invalid URL, host is missing")
+	}
+
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", errors.New("invalid URL, query and fragment are not supported")
+	}
+
+	basePath := strings.Trim(parsed.Path, "/")
+	urlBase = strings.Trim(urlBase, "/")
+
+	if basePath != "" && urlBase != "" && basePath != urlBase {
+		return "", fmt.Errorf("URL path '/%s' conflicts with URL base '/%s'", basePath, urlBase)
+	}
+
+	if urlBase != "" {
+		basePath = urlBase
+	}
+
+	parsed.Path = ""
+	parsed.RawPath = ""
+
+	if basePath != "" {
+		parsed.Path = "/" + basePath
+	}
+
+	return parsed.String(), nil
+}
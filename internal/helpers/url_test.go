@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url      string
+		urlBase  string
+		expected string
+		err      bool
+	}{
+		"plain": {
+			url:      "http://localhost:9696",
+			expected: "http://localhost:9696",
+		},
+		"trailing slash": {
+			url:      "http://localhost:9696/",
+			expected: "http://localhost:9696",
+		},
+		"path": {
+			url:      "https://home.example/prowlarr/",
+			expected: "https://home.example/prowlarr",
+		},
+		"url base": {
+			url:      "https://home.example",
+			urlBase:  "/prowlarr",
+			expected: "https://home.example/prowlarr",
+		},
+		"same path and url base": {
+			url:      "https://home.example/prowlarr",
+			urlBase:  "prowlarr/",
+			expected: "https://home.example/prowlarr",
+		},
+		"conflicting path": {
+			url:     "https://home.example/prowlarr",
+			urlBase: "/other",
+			err:     true,
+		},
+		"missing scheme": {
+			url: "localhost:9696",
+			err: true,
+		},
+		"query": {
+			url: "http://localhost:9696?a=b",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			url, err := NormalizeURL(test.url, test.urlBase)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, url)
+		})
+	}
+}
+
+func TestNormalizeURLPaths(t *testing.T) {
+	t.Parallel()
+
+	var requested string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"label":"test"}`))
+	}))
+	defer server.Close()
+
+	url, err := NormalizeURL(server.URL+"/prowlarr/", "")
+	assert.Nil(t, err)
+
+	config := prowlarr.NewConfiguration()
+	config.Servers[0].URL = url
+
+	_, _, err = prowlarr.NewAPIClient(config).TagApi.GetTagById(context.TODO(), 1).Execute()
+	assert.Nil(t, err)
+	assert.Equal(t, "/prowlarr/api/v1/tag/1", requested)
+}
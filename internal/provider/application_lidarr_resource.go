@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationLidarrResource{}
 	_ resource.ResourceWithImportState = &ApplicationLidarrResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationLidarrResource{}
 )
 
 func NewApplicationLidarrResource() resource.Resource {
@@ -37,7 +38,10 @@ func NewApplicationLidarrResource() resource.Resource {
 
 // ApplicationLidarrResource defines the application implementation.
 type ApplicationLidarrResource struct {
-	client *prowlarr.APIClient
+	client       *prowlarr.APIClient
+	defaultTags  *helpers.DefaultTags
+	testOnApply  bool
+	providerData *helpers.ProviderData
 }
 
 // ApplicationLidarr describes the application data model.
@@ -50,6 +54,8 @@ type ApplicationLidarr struct {
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (a ApplicationLidarr) toApplication() *Application {
@@ -110,6 +116,14 @@ func (r *ApplicationLidarrResource) Schema(_ context.Context, _ resource.SchemaR
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -125,7 +139,7 @@ func (r *ApplicationLidarrResource) Schema(_ context.Context, _ resource.SchemaR
 				Sensitive:           true,
 			},
 			"sync_categories": schema.SetAttribute{
-				MarkdownDescription: "Sync categories.",
+				MarkdownDescription: "Sync category IDs. Unknown IDs are rejected, suggesting the category with the nearest ID.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
@@ -135,11 +149,19 @@ func (r *ApplicationLidarrResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *ApplicationLidarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+		r.providerData = data
 	}
 }
 
+func (r *ApplicationLidarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+	validateSyncCategories(ctx, r.providerData, req, resp, "sync_categories")
+}
+
 func (r *ApplicationLidarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var application *ApplicationLidarr
@@ -150,12 +172,23 @@ func (r *ApplicationLidarrResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ApplicationLidarr
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.CreateApplications(ctx).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Create, applicationLidarrResourceName, err) {
+		response, _, err = r.client.ApplicationApi.CreateApplications(helpers.ContextWithForceSave(ctx)).ApplicationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationLidarrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, applicationLidarrResourceName, err)
 
 		return
 	}
@@ -164,6 +197,13 @@ func (r *ApplicationLidarrResource) Create(ctx context.Context, req resource.Cre
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationLidarrResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *ApplicationLidarrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -177,9 +217,9 @@ func (r *ApplicationLidarrResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get ApplicationLidarr current value
-	response, _, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationLidarrResourceName, err))
+		helpers.HandleReadError(ctx, applicationLidarrResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -200,12 +240,23 @@ func (r *ApplicationLidarrResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ApplicationLidarr
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Update, applicationLidarrResourceName, err) {
+		response, _, err = r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationLidarrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, applicationLidarrResourceName, err)
 
 		return
 	}
@@ -214,6 +265,13 @@ func (r *ApplicationLidarrResource) Update(ctx context.Context, req resource.Upd
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationLidarrResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *ApplicationLidarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
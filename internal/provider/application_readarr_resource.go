@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationReadarrResource{}
 	_ resource.ResourceWithImportState = &ApplicationReadarrResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationReadarrResource{}
 )
 
 func NewApplicationReadarrResource() resource.Resource {
@@ -37,7 +38,10 @@ func NewApplicationReadarrResource() resource.Resource {
 
 // ApplicationReadarrResource defines the application implementation.
 type ApplicationReadarrResource struct {
-	client *prowlarr.APIClient
+	client       *prowlarr.APIClient
+	defaultTags  *helpers.DefaultTags
+	testOnApply  bool
+	providerData *helpers.ProviderData
 }
 
 // ApplicationReadarr describes the application data model.
@@ -50,6 +54,8 @@ type ApplicationReadarr struct {
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (a ApplicationReadarr) toApplication() *Application {
@@ -110,6 +116,14 @@ func (r *ApplicationReadarrResource) Schema(_ context.Context, _ resource.Schema
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -125,7 +139,7 @@ func (r *ApplicationReadarrResource) Schema(_ context.Context, _ resource.Schema
 				Sensitive:           true,
 			},
 			"sync_categories": schema.SetAttribute{
-				MarkdownDescription: "Sync categories.",
+				MarkdownDescription: "Sync category IDs. Unknown IDs are rejected, suggesting the category with the nearest ID.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
@@ -135,11 +149,19 @@ func (r *ApplicationReadarrResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *ApplicationReadarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+		r.providerData = data
 	}
 }
 
+func (r *ApplicationReadarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+	validateSyncCategories(ctx, r.providerData, req, resp, "sync_categories")
+}
+
 func (r *ApplicationReadarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var application *ApplicationReadarr
@@ -150,12 +172,23 @@ func (r *ApplicationReadarrResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ApplicationReadarr
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.CreateApplications(ctx).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Create, applicationReadarrResourceName, err) {
+		response, _, err = r.client.ApplicationApi.CreateApplications(helpers.ContextWithForceSave(ctx)).ApplicationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationReadarrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, applicationReadarrResourceName, err)
 
 		return
 	}
@@ -164,6 +197,13 @@ func (r *ApplicationReadarrResource) Create(ctx context.Context, req resource.Cr
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationReadarrResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *ApplicationReadarrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -177,9 +217,9 @@ func (r *ApplicationReadarrResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get ApplicationReadarr current value
-	response, _, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationReadarrResourceName, err))
+		helpers.HandleReadError(ctx, applicationReadarrResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -200,12 +240,23 @@ func (r *ApplicationReadarrResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ApplicationReadarr
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Update, applicationReadarrResourceName, err) {
+		response, _, err = r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationReadarrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, applicationReadarrResourceName, err)
 
 		return
 	}
@@ -214,6 +265,13 @@ func (r *ApplicationReadarrResource) Update(ctx context.Context, req resource.Up
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationReadarrResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *ApplicationReadarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -26,6 +26,7 @@ const applicationResourceName = "application"
 var (
 	_ resource.Resource                = &ApplicationResource{}
 	_ resource.ResourceWithImportState = &ApplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationResource{}
 )
 
 var applicationFields = helpers.Fields{
@@ -39,7 +40,10 @@ func NewApplicationResource() resource.Resource {
 
 // ApplicationResource defines the application implementation.
 type ApplicationResource struct {
-	client *prowlarr.APIClient
+	client       *prowlarr.APIClient
+	defaultTags  *helpers.DefaultTags
+	testOnApply  bool
+	providerData *helpers.ProviderData
 }
 
 // Application describes the application data model.
@@ -57,6 +61,53 @@ type Application struct {
 	ID                  types.Int64  `tfsdk:"id"`
 }
 
+// ApplicationGeneric describes the generic application resource data model.
+type ApplicationGeneric struct {
+	SyncCategories      types.Set    `tfsdk:"sync_categories"`
+	AnimeSyncCategories types.Set    `tfsdk:"anime_sync_categories"`
+	Tags                types.Set    `tfsdk:"tags"`
+	Name                types.String `tfsdk:"name"`
+	ConfigContract      types.String `tfsdk:"config_contract"`
+	Implementation      types.String `tfsdk:"implementation"`
+	SyncLevel           types.String `tfsdk:"sync_level"`
+	ProwlarrURL         types.String `tfsdk:"prowlarr_url"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	APIKey              types.String `tfsdk:"api_key"`
+	ID                  types.Int64  `tfsdk:"id"`
+	ForceSave           types.Bool   `tfsdk:"force_save"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (a ApplicationGeneric) toApplication() *Application {
+	return &Application{
+		SyncCategories:      a.SyncCategories,
+		AnimeSyncCategories: a.AnimeSyncCategories,
+		Tags:                a.Tags,
+		Name:                a.Name,
+		ConfigContract:      a.ConfigContract,
+		Implementation:      a.Implementation,
+		SyncLevel:           a.SyncLevel,
+		ProwlarrURL:         a.ProwlarrURL,
+		BaseURL:             a.BaseURL,
+		APIKey:              a.APIKey,
+		ID:                  a.ID,
+	}
+}
+
+func (a *ApplicationGeneric) fromApplication(application *Application) {
+	a.SyncCategories = application.SyncCategories
+	a.AnimeSyncCategories = application.AnimeSyncCategories
+	a.Tags = application.Tags
+	a.Name = application.Name
+	a.ConfigContract = application.ConfigContract
+	a.Implementation = application.Implementation
+	a.SyncLevel = application.SyncLevel
+	a.ProwlarrURL = application.ProwlarrURL
+	a.BaseURL = application.BaseURL
+	a.APIKey = application.APIKey
+	a.ID = application.ID
+}
+
 func (a Application) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -114,6 +165,14 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -132,13 +191,13 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Sensitive:           true,
 			},
 			"sync_categories": schema.SetAttribute{
-				MarkdownDescription: "Sync categories.",
+				MarkdownDescription: "Sync category IDs. Unknown IDs are rejected, suggesting the category with the nearest ID.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
 			"anime_sync_categories": schema.SetAttribute{
-				MarkdownDescription: "Anime sync categories.",
+				MarkdownDescription: "Anime sync category IDs. Unknown IDs are rejected, suggesting the category with the nearest ID.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
@@ -148,14 +207,22 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+		r.providerData = data
 	}
 }
 
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+	validateSyncCategories(ctx, r.providerData, req, resp, "sync_categories", "anime_sync_categories")
+}
+
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var application *Application
+	var application *ApplicationGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -163,28 +230,43 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Application
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.CreateApplications(ctx).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Create, applicationResourceName, err) {
+		response, _, err = r.client.ApplicationApi.CreateApplications(helpers.ContextWithForceSave(ctx)).ApplicationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, applicationResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	application.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, application)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var application *Application
+	var application *ApplicationGeneric
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &application)...)
 
@@ -193,25 +275,22 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get Application current value
-	response, _, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ApplicationApi.GetApplicationsById(ctx, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationResourceName, err))
+		helpers.HandleReadError(ctx, applicationResourceName, httpResp, err, resp)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
-
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	application.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, application)...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var application *Application
+	var application *ApplicationGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -219,23 +298,38 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &application.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Application
 	request := application.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, application.ForceSave.ValueBool(), helpers.Update, applicationResourceName, err) {
+		response, _, err = r.client.ApplicationApi.UpdateApplications(ctx, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, applicationResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	application.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, application)...)
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, applicationResourceName, application.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.ApplicationApi.TestApplications(ctx).ApplicationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,6 +358,21 @@ func (r *ApplicationResource) ImportState(ctx context.Context, req resource.Impo
 	tflog.Trace(ctx, "imported "+applicationResourceName+": "+req.ID)
 }
 
+func (a *ApplicationGeneric) write(ctx context.Context, application *prowlarr.ApplicationResource, diags *diag.Diagnostics) {
+	// this is needed because of many empty fields are unknown in both plan and read
+	var state Application
+
+	state.write(ctx, application, diags)
+
+	// prior secrets are kept, since Prowlarr returns them masked.
+	state.APIKey = helpers.UnmaskString(state.APIKey, a.APIKey)
+	a.fromApplication(&state)
+}
+
+func (a *ApplicationGeneric) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.ApplicationResource {
+	return a.toApplication().read(ctx, diags)
+}
+
 func (a *Application) write(ctx context.Context, application *prowlarr.ApplicationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -291,3 +400,45 @@ func (a *Application) read(ctx context.Context, diags *diag.Diagnostics) *prowla
 
 	return application
 }
+
+// validateSyncCategories adds an attribute error for each configured category unknown to Prowlarr.
+func validateSyncCategories(ctx context.Context, providerData *helpers.ProviderData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	// Nothing to validate on destroy or without a configured provider.
+	if req.Plan.Raw.IsNull() || providerData == nil {
+		return
+	}
+
+	configured := map[string][]int64{}
+
+	for _, attribute := range attributes {
+		var value types.Set
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		ids := make([]int64, 0, len(value.Elements()))
+		resp.Diagnostics.Append(value.ElementsAs(ctx, &ids, true)...)
+		configured[attribute] = ids
+	}
+
+	if len(configured) == 0 || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validation is skipped if categories are not reachable, errors will be reported on apply.
+	categories, err := providerData.IndexerCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate sync categories", helpers.ParseClientError(helpers.Read, indexerCategoriesDataSourceName, err))
+
+		return
+	}
+
+	for _, attribute := range attributes {
+		for _, message := range helpers.UnknownIndexerCategories(categories, configured[attribute]) {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Unknown Indexer Category", message)
+		}
+	}
+}
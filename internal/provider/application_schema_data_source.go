@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const applicationSchemaDataSourceName = "application_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationSchemaDataSource{}
+
+func NewApplicationSchemaDataSource() datasource.DataSource {
+	return &ApplicationSchemaDataSource{}
+}
+
+// ApplicationSchemaDataSource defines the application schema implementation.
+type ApplicationSchemaDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// ApplicationSchema describes the application schema data model.
+type ApplicationSchema struct {
+	Fields             types.Set    `tfsdk:"fields"`
+	Implementation     types.String `tfsdk:"implementation"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+	InfoLink           types.String `tfsdk:"info_link"`
+	ID                 types.Int64  `tfsdk:"id"`
+}
+
+func (d *ApplicationSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + applicationSchemaDataSourceName
+}
+
+func (d *ApplicationSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Applications -->Application schema definition, to be used with the generic [Application](../resources/application).",
+		Attributes: map[string]schema.Attribute{
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Application implementation name.",
+				Required:            true,
+			},
+			"implementation_name": schema.StringAttribute{
+				MarkdownDescription: "Application implementation display name.",
+				Computed:            true,
+			},
+			"config_contract": schema.StringAttribute{
+				MarkdownDescription: "Application configuration template.",
+				Computed:            true,
+			},
+			"info_link": schema.StringAttribute{
+				MarkdownDescription: "Link to the application documentation.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Schema ID.",
+				Computed:            true,
+			},
+			"fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of configuration fields.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IndexerSchemaDataSource{}.getFieldSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ApplicationSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ApplicationSchema
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get application schemas current value
+	response, httpResp, err := d.client.ApplicationApi.ListApplicationsSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationSchemaDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Implementation.ValueString(), response, httpResp, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+applicationSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *ApplicationSchema) find(ctx context.Context, implementation string, schemas []*prowlarr.ApplicationResource, httpResp *http.Response, diags *diag.Diagnostics) {
+	for id, item := range schemas {
+		if item.GetImplementation() == implementation {
+			s.ID = types.Int64Value(int64(id))
+			s.write(ctx, item, decodeSchemaFieldPrivacy(httpResp, id, applicationSchemaDataSourceName, diags), diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(applicationSchemaDataSourceName, "implementation", implementation))
+}
+
+func (s *ApplicationSchema) write(ctx context.Context, item *prowlarr.ApplicationResource, fieldPrivacy map[string]string, diags *diag.Diagnostics) {
+	s.Implementation = types.StringValue(item.GetImplementation())
+	s.ImplementationName = types.StringValue(item.GetImplementationName())
+	s.ConfigContract = types.StringValue(item.GetConfigContract())
+	s.InfoLink = types.StringValue(item.GetInfoLink())
+	s.Fields = writeSchemaFields(ctx, item.GetFields(), fieldPrivacy, diags)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccApplicationSchemaDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccApplicationSchemaDataSourceConfig("error"),
+				ExpectError: regexp.MustCompile("Unable to find application_schema"),
+			},
+			// Read testing
+			{
+				Config: testAccApplicationSchemaDataSourceConfig("Sonarr"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_application_schema.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_application_schema.test", "implementation", "Sonarr"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_application_schema.test", "fields.*", map[string]string{"name": "syncCategories"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationSchemaDataSourceConfig(implementation string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_application_schema" "test" {
+		implementation = "%s"
+	}
+	`, implementation)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const applicationSchemasDataSourceName = "application_schemas"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationSchemasDataSource{}
+
+func NewApplicationSchemasDataSource() datasource.DataSource {
+	return &ApplicationSchemasDataSource{}
+}
+
+// ApplicationSchemasDataSource defines the application schemas implementation.
+type ApplicationSchemasDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// ApplicationSchemas describes the application schemas data model.
+type ApplicationSchemas struct {
+	ApplicationSchemas types.List   `tfsdk:"application_schemas"`
+	ID                 types.String `tfsdk:"id"`
+}
+
+func (d *ApplicationSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + applicationSchemasDataSourceName
+}
+
+func (d *ApplicationSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Applications -->List all available [Application Schemas](../data-sources/application_schema).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"application_schemas": schema.ListAttribute{
+				MarkdownDescription: "Application implementation list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ApplicationSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ApplicationSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get application schemas current value
+	response, _, err := d.client.ApplicationApi.ListApplicationsSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationSchemasDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+applicationSchemasDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]string, len(response))
+	for i, s := range response {
+		schemas[i] = s.GetImplementation()
+	}
+
+	schemaList, diags := types.ListValueFrom(ctx, types.StringType, schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, ApplicationSchemas{ApplicationSchemas: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -20,6 +20,11 @@ func TestAccApplicationSonarrResource(t *testing.T) {
 				Config:      testAccApplicationSonarrResourceConfig("resourceSonarrTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Unknown category
+			{
+				Config:      testAccApplicationSonarrResourceCategoryConfig,
+				ExpectError: regexp.MustCompile("Unknown Indexer Category"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccApplicationSonarrResourceConfig("resourceSonarrTest", "false"),
@@ -64,3 +69,15 @@ func testAccApplicationSonarrResourceConfig(name, prowlarr string) string {
 		anime_sync_categories = [5070]
 	}`, name, prowlarr)
 }
+
+const testAccApplicationSonarrResourceCategoryConfig = `
+resource "prowlarr_application_sonarr" "test" {
+	name = "resourceSonarrCategory"
+	sync_level = "disabled"
+
+	base_url = "http://localhost:8989"
+	prowlarr_url = "http://localhost:9696"
+	api_key = "APIKey"
+	sync_categories = [5010, 5021]
+}
+`
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	applicationSyncResourceName = "application_sync"
+	applicationSyncCommand      = "ApplicationIndexerSync"
+	defaultCommandTimeout       = 300
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApplicationSyncResource{}
+
+func NewApplicationSyncResource() resource.Resource {
+	return &ApplicationSyncResource{}
+}
+
+// ApplicationSyncResource defines the application sync implementation.
+type ApplicationSyncResource struct {
+	client *prowlarr.APIClient
+}
+
+// ApplicationSync describes the application sync data model.
+type ApplicationSync struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	Status   types.String `tfsdk:"status"`
+	ID       types.Int64  `tfsdk:"id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *ApplicationSyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + applicationSyncResourceName
+}
+
+func (r *ApplicationSyncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Applications -->Application Sync resource. It triggers the sync of the indexers to all the applications and waits for its completion.\nThe sync runs again when any of the `triggers` changes, destroying the resource does nothing.\nA failed or timed out sync is saved as tainted, to be run again on the next apply.\nFor more information refer to [Application](https://wiki.servarr.com/prowlarr/settings#applications).",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the sync again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait for the sync to complete. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultCommandTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ApplicationSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var sync *ApplicationSync
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &sync)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the sync command
+	request := prowlarr.NewCommandResource()
+	request.SetName(applicationSyncCommand)
+
+	response, _, err := r.client.CommandApi.CreateCommand(ctx).CommandResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationSyncResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+applicationSyncResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Wait for the sync to complete
+	command, err := helpers.WaitForCommand(ctx, r.client, response.GetId(), time.Duration(sync.Timeout.ValueInt64())*time.Second, helpers.CommandPollWaitMin, helpers.CommandPollWaitMax)
+	if command != nil {
+		response = command
+	}
+
+	// the last read command is saved also on failure, tainting the resource to run the sync again.
+	sync.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sync)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationSyncResourceName, err))
+	}
+}
+
+func (r *ApplicationSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var sync *ApplicationSync
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &sync)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The command is not read back, since Prowlarr purges the completed ones
+	tflog.Trace(ctx, "read "+applicationSyncResourceName+": "+strconv.Itoa(int(sync.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sync)...)
+}
+
+func (r *ApplicationSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var sync *ApplicationSync
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &sync)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout can be updated in place, no command is run
+	tflog.Trace(ctx, "updated "+applicationSyncResourceName+": "+strconv.Itoa(int(sync.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sync)...)
+}
+
+func (r *ApplicationSyncResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "deleted "+applicationSyncResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (s *ApplicationSync) write(command *prowlarr.CommandResource) {
+	s.ID = types.Int64Value(int64(command.GetId()))
+	s.Status = types.StringValue(string(command.GetStatus()))
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationSyncResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccApplicationSyncResourceConfig("first", 300) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccApplicationSyncResourceConfig("first", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_application_sync.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("prowlarr_application_sync.test", "id"),
+				),
+			},
+			// Update timeout testing
+			{
+				Config: testAccApplicationSyncResourceConfig("first", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_application_sync.test", "timeout", "600"),
+					resource.TestCheckResourceAttr("prowlarr_application_sync.test", "status", "completed"),
+				),
+			},
+			// Trigger testing
+			{
+				Config: testAccApplicationSyncResourceConfig("second", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_application_sync.test", "triggers.key", "second"),
+					resource.TestCheckResourceAttr("prowlarr_application_sync.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApplicationSyncResourceConfig(trigger string, timeout int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_application_sync" "test" {
+		triggers = {
+			key = "%s"
+		}
+		timeout = %d
+	}`, trigger, timeout)
+}
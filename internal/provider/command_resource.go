@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName = "command"
+	// commandAPIPath is the path of the command API, whose SDK model does not include the command parameters.
+	commandAPIPath = "/api/v1/command"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	client *prowlarr.APIClient
+}
+
+// Command describes the command data model.
+type Command struct {
+	Body              types.Map    `tfsdk:"body"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	Name              types.String `tfsdk:"name"`
+	Status            types.String `tfsdk:"status"`
+	Started           types.String `tfsdk:"started"`
+	Ended             types.String `tfsdk:"ended"`
+	Message           types.String `tfsdk:"message"`
+	ID                types.Int64  `tfsdk:"id"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->Command resource. It runs a Prowlarr command (e.g. `Backup`, `CheckHealth`, `ApplicationUpdate`, `Housekeeping`) and optionally waits for its completion.\nThe command runs again when any of `name`, `body` or `triggers` changes, destroying the resource does nothing.\nA failed or timed out command is saved as tainted, to be run again on the next apply.\nFor more information refer to [Tasks](https://wiki.servarr.com/prowlarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.MapAttribute{
+				MarkdownDescription: "Additional command parameters. Values are sent as JSON when valid (e.g. `true` or `[1, 2]`), as strings otherwise.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the command again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the command to complete, reporting its failure as error. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait for the command to complete. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultCommandTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				MarkdownDescription: "Command start time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ended": schema.StringAttribute{
+				MarkdownDescription: "Command end time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Command message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the command
+	body := map[string]string{}
+	resp.Diagnostics.Append(command.Body.ElementsAs(ctx, &body, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := prowlarr.NewCommandResource()
+	request.SetName(command.Name.ValueString())
+
+	response, _, err := r.client.CommandApi.CreateCommand(helpers.ContextWithBodyFields(ctx, commandAPIPath, commandBody(body))).CommandResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Wait for the command to complete
+	if command.WaitForCompletion.ValueBool() {
+		var result *prowlarr.CommandResource
+
+		result, err = helpers.WaitForCommand(ctx, r.client, response.GetId(), time.Duration(command.Timeout.ValueInt64())*time.Second, helpers.CommandPollWaitMin, helpers.CommandPollWaitMax)
+		if result != nil {
+			response = result
+		}
+	}
+
+	// the last read command is saved also on failure, tainting the resource to run the command again.
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+	}
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The command is not read back, since Prowlarr purges the completed ones
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the wait parameters can be updated in place, no command is run
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "deleted "+commandResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (c *Command) write(command *prowlarr.CommandResource) {
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Message = types.StringValue(command.GetMessage())
+	c.Started = types.StringNull()
+	c.Ended = types.StringNull()
+
+	if started, ok := command.GetStartedOk(); ok && started != nil {
+		c.Started = types.StringValue(started.Format(time.RFC3339))
+	}
+
+	if ended, ok := command.GetEndedOk(); ok && ended != nil {
+		c.Ended = types.StringValue(ended.Format(time.RFC3339))
+	}
+}
+
+// commandBody converts the body map values to JSON values when possible.
+func commandBody(body map[string]string) map[string]any {
+	fields := make(map[string]any, len(body))
+
+	for k, v := range body {
+		var value any
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			value = v
+		}
+
+		fields[k] = value
+	}
+
+	return fields
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("CheckHealth", "true") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("prowlarr_command.test", "id"),
+					resource.TestCheckResourceAttrSet("prowlarr_command.test", "ended"),
+				),
+			},
+			// Update wait testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+				),
+			},
+			// Replace testing
+			{
+				Config: testAccCommandResourceConfig("Housekeeping", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "name", "Housekeeping"),
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+				),
+			},
+			// Body testing
+			{
+				Config: testAccCommandResourceBodyConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "name", "ApplicationIndexerSync"),
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, wait string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_command" "test" {
+		name = "%s"
+		wait_for_completion = %s
+	}`, name, wait)
+}
+
+const testAccCommandResourceBodyConfig = `
+resource "prowlarr_command" "test" {
+	name = "ApplicationIndexerSync"
+	body = {
+		forceSync = "true"
+	}
+}
+`
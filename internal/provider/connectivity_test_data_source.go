@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const connectivityTestDataSourceName = "connectivity_test"
+
+// connectivityTestKinds are the supported kinds, mapped to their test all calls.
+var connectivityTestKinds = map[string]func(context.Context, *prowlarr.APIClient) (*http.Response, error){
+	"applications": func(ctx context.Context, client *prowlarr.APIClient) (*http.Response, error) {
+		return client.ApplicationApi.TestallApplications(ctx).Execute()
+	},
+	"download_clients": func(ctx context.Context, client *prowlarr.APIClient) (*http.Response, error) {
+		return client.DownloadClientApi.TestallDownloadClient(ctx).Execute()
+	},
+	"indexers": func(ctx context.Context, client *prowlarr.APIClient) (*http.Response, error) {
+		return client.IndexerApi.TestallIndexer(ctx).Execute()
+	},
+	"indexer_proxies": func(ctx context.Context, client *prowlarr.APIClient) (*http.Response, error) {
+		return client.IndexerProxyApi.TestallIndexerProxy(ctx).Execute()
+	},
+	"notifications": func(ctx context.Context, client *prowlarr.APIClient) (*http.Response, error) {
+		return client.NotificationApi.TestallNotification(ctx).Execute()
+	},
+}
+
+// connectivityTestKindList is the ordered list of the supported kinds.
+var connectivityTestKindList = []string{"applications", "download_clients", "indexers", "indexer_proxies", "notifications"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectivityTestDataSource{}
+
+func NewConnectivityTestDataSource() datasource.DataSource {
+	return &ConnectivityTestDataSource{}
+}
+
+// ConnectivityTestDataSource defines the connectivity test implementation.
+type ConnectivityTestDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// ConnectivityTest describes the connectivity test data model.
+type ConnectivityTest struct {
+	Kinds   types.Set    `tfsdk:"kinds"`
+	Results types.Set    `tfsdk:"results"`
+	ID      types.String `tfsdk:"id"`
+	IsValid types.Bool   `tfsdk:"is_valid"`
+}
+
+// ConnectivityTestResult describes the test result of a single item.
+type ConnectivityTestResult struct {
+	Errors   types.List   `tfsdk:"errors"`
+	Warnings types.List   `tfsdk:"warnings"`
+	Kind     types.String `tfsdk:"kind"`
+	ID       types.Int64  `tfsdk:"id"`
+	IsValid  types.Bool   `tfsdk:"is_valid"`
+}
+
+func (r ConnectivityTestResult) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"errors":   types.ListType{}.WithElementType(types.StringType),
+			"warnings": types.ListType{}.WithElementType(types.StringType),
+			"kind":     types.StringType,
+			"id":       types.Int64Type,
+			"is_valid": types.BoolType,
+		})
+}
+
+func (d *ConnectivityTestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + connectivityTestDataSourceName
+}
+
+func (d *ConnectivityTestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->Run the test of all the configured applications, download clients, indexers, indexer proxies and notifications, returning the result of each item.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"kinds": schema.SetAttribute{
+				MarkdownDescription: "Kinds to be tested. Defaults to all of `" + strings.Join(connectivityTestKindList, "`, `") + "`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(connectivityTestKindList...)),
+				},
+			},
+			"is_valid": schema.BoolAttribute{
+				MarkdownDescription: "True if all the tests succeeded.",
+				Computed:            true,
+			},
+			"results": schema.SetNestedAttribute{
+				MarkdownDescription: "Test results.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the tested item.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the tested item.",
+							Computed:            true,
+						},
+						"is_valid": schema.BoolAttribute{
+							MarkdownDescription: "True if the test succeeded.",
+							Computed:            true,
+						},
+						"errors": schema.ListAttribute{
+							MarkdownDescription: "Test failure messages.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"warnings": schema.ListAttribute{
+							MarkdownDescription: "Test warning messages.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectivityTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ConnectivityTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ConnectivityTest
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kinds := connectivityTestKindList
+	if !data.Kinds.IsNull() && !data.Kinds.IsUnknown() {
+		kinds = make([]string, 0, len(data.Kinds.Elements()))
+		resp.Diagnostics.Append(data.Kinds.ElementsAs(ctx, &kinds, false)...)
+	}
+
+	results := []ConnectivityTestResult{}
+	data.IsValid = types.BoolValue(true)
+
+	for _, kind := range kinds {
+		// Run the test of all items of the kind
+		httpResp, err := connectivityTestKinds[kind](ctx, d.client)
+		// a bad request is returned when any of the items is not valid
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusBadRequest) {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.RunTest, kind, err))
+
+			return
+		}
+
+		var response []helpers.TestResult
+		if err := helpers.DecodeBody(httpResp, &response); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.RunTest, kind, err))
+
+			return
+		}
+
+		for _, r := range response {
+			result := ConnectivityTestResult{}
+			result.write(ctx, kind, r, &resp.Diagnostics)
+			results = append(results, result)
+
+			if !r.IsValid {
+				data.IsValid = types.BoolValue(false)
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "read "+connectivityTestDataSourceName)
+
+	var tempDiag diag.Diagnostics
+
+	data.Results, tempDiag = types.SetValueFrom(ctx, ConnectivityTestResult{}.getType(), results)
+	resp.Diagnostics.Append(tempDiag...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(connectivityTestDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConnectivityTestResult) write(ctx context.Context, kind string, result helpers.TestResult, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	errors := []string{}
+	warnings := []string{}
+
+	for _, f := range result.ValidationFailures {
+		if f.IsWarning {
+			warnings = append(warnings, f.ErrorMessage)
+		} else {
+			errors = append(errors, f.ErrorMessage)
+		}
+	}
+
+	r.Kind = types.StringValue(kind)
+	r.ID = types.Int64Value(result.ID)
+	r.IsValid = types.BoolValue(result.IsValid)
+	r.Errors, tempDiag = types.ListValueFrom(ctx, types.StringType, errors)
+	diags.Append(tempDiag...)
+	r.Warnings, tempDiag = types.ListValueFrom(ctx, types.StringType, warnings)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectivityTestDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccConnectivityTestDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid kind
+			{
+				Config:      testAccConnectivityTestDataSourceKindConfig("wrong"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccConnectivityTestDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_connectivity_test.test", "id"),
+					resource.TestCheckResourceAttrSet("data.prowlarr_connectivity_test.test", "is_valid"),
+				),
+			},
+			// Read single kind testing
+			{
+				Config: testAccConnectivityTestDataSourceKindConfig("notifications"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_connectivity_test.test", "is_valid"),
+				),
+			},
+		},
+	})
+}
+
+const testAccConnectivityTestDataSourceConfig = `
+data "prowlarr_connectivity_test" "test" {
+}
+`
+
+func testAccConnectivityTestDataSourceKindConfig(kind string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_connectivity_test" "test" {
+		kinds = ["%s"]
+	}
+	`, kind)
+}
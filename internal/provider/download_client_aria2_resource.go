@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientAria2Resource{}
 	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -35,7 +36,9 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClientAria2 describes the download client data model.
@@ -51,6 +54,8 @@ type DownloadClientAria2 struct {
 	ID          types.Int64  `tfsdk:"id"`
 	UseSsl      types.Bool   `tfsdk:"use_ssl"`
 	Enable      types.Bool   `tfsdk:"enable"`
+	ForceSave   types.Bool   `tfsdk:"force_save"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -128,6 +133,14 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"use_ssl": schema.BoolAttribute{
 				MarkdownDescription: "Use SSL flag.",
@@ -159,11 +172,17 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientAria2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientAria2
@@ -174,12 +193,23 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientAria2ResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientAria2ResourceName, err)
 
 		return
 	}
@@ -188,6 +218,13 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientAria2ResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -201,9 +238,9 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientAria2ResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -224,12 +261,23 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientAria2ResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientAria2ResourceName, err)
 
 		return
 	}
@@ -238,6 +286,13 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientAria2ResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientDelugeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -37,7 +38,9 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClientDeluge describes the download client data model.
@@ -56,6 +59,8 @@ type DownloadClientDeluge struct {
 	AddPaused    types.Bool   `tfsdk:"add_paused"`
 	UseSsl       types.Bool   `tfsdk:"use_ssl"`
 	Enable       types.Bool   `tfsdk:"enable"`
+	ForceSave    types.Bool   `tfsdk:"force_save"`
+	TestOnApply  types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -140,6 +145,14 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"add_paused": schema.BoolAttribute{
 				MarkdownDescription: "Add paused flag.",
@@ -190,11 +203,17 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientDelugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientDeluge
@@ -205,12 +224,23 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientDelugeResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientDelugeResourceName, err)
 
 		return
 	}
@@ -219,6 +249,13 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientDelugeResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -232,9 +269,9 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientDelugeResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -255,12 +292,23 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientDelugeResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientDelugeResourceName, err)
 
 		return
 	}
@@ -269,6 +317,13 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientDelugeResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFloodResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -35,7 +36,9 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClientFlood describes the download client data model.
@@ -56,6 +59,8 @@ type DownloadClientFlood struct {
 	AddPaused      types.Bool   `tfsdk:"add_paused"`
 	UseSsl         types.Bool   `tfsdk:"use_ssl"`
 	Enable         types.Bool   `tfsdk:"enable"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -144,6 +149,14 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"add_paused": schema.BoolAttribute{
 				MarkdownDescription: "Add paused flag.",
@@ -203,11 +216,17 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientFloodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFlood
@@ -218,12 +237,23 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientFloodResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientFloodResourceName, err)
 
 		return
 	}
@@ -232,6 +262,13 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientFloodResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -245,9 +282,9 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFloodResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -268,12 +305,23 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientFloodResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientFloodResourceName, err)
 
 		return
 	}
@@ -282,6 +330,13 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientFloodResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFreeboxResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFreeboxResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFreeboxResource{}
 )
 
 func NewDownloadClientFreeboxResource() resource.Resource {
@@ -37,7 +38,9 @@ func NewDownloadClientFreeboxResource() resource.Resource {
 
 // DownloadClientFreeboxResource defines the download client implementation.
 type DownloadClientFreeboxResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClientFreebox describes the download client data model.
@@ -58,6 +61,8 @@ type DownloadClientFreebox struct {
 	AddPaused            types.Bool   `tfsdk:"add_paused"`
 	UseSsl               types.Bool   `tfsdk:"use_ssl"`
 	Enable               types.Bool   `tfsdk:"enable"`
+	ForceSave            types.Bool   `tfsdk:"force_save"`
+	TestOnApply          types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFreebox) toDownloadClient() *DownloadClient {
@@ -145,6 +150,14 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"add_paused": schema.BoolAttribute{
 				MarkdownDescription: "Add paused flag.",
@@ -200,11 +213,17 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *DownloadClientFreeboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientFreeboxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFreebox
@@ -215,12 +234,23 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientFreeboxResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFreeboxResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientFreeboxResourceName, err)
 
 		return
 	}
@@ -229,6 +259,13 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientFreeboxResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -242,9 +279,9 @@ func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get DownloadClientFreebox current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFreeboxResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFreeboxResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -265,12 +302,23 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientFreeboxResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFreeboxResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientFreeboxResourceName, err)
 
 		return
 	}
@@ -279,6 +327,13 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientFreeboxResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
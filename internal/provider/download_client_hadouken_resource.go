@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientHadoukenResource{}
 	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -35,24 +36,28 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
-	Tags       types.Set    `tfsdk:"tags"`
-	Categories types.Set    `tfsdk:"categories"`
-	Name       types.String `tfsdk:"name"`
-	Host       types.String `tfsdk:"host"`
-	URLBase    types.String `tfsdk:"url_base"`
-	Username   types.String `tfsdk:"username"`
-	Password   types.String `tfsdk:"password"`
-	Category   types.String `tfsdk:"category"`
-	Priority   types.Int64  `tfsdk:"priority"`
-	Port       types.Int64  `tfsdk:"port"`
-	ID         types.Int64  `tfsdk:"id"`
-	UseSsl     types.Bool   `tfsdk:"use_ssl"`
-	Enable     types.Bool   `tfsdk:"enable"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Categories  types.Set    `tfsdk:"categories"`
+	Name        types.String `tfsdk:"name"`
+	Host        types.String `tfsdk:"host"`
+	URLBase     types.String `tfsdk:"url_base"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Category    types.String `tfsdk:"category"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Port        types.Int64  `tfsdk:"port"`
+	ID          types.Int64  `tfsdk:"id"`
+	UseSsl      types.Bool   `tfsdk:"use_ssl"`
+	Enable      types.Bool   `tfsdk:"enable"`
+	ForceSave   types.Bool   `tfsdk:"force_save"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -134,6 +139,14 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"use_ssl": schema.BoolAttribute{
 				MarkdownDescription: "Use SSL flag.",
@@ -174,11 +187,17 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientHadoukenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
@@ -189,12 +208,23 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientHadoukenResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientHadoukenResourceName, err)
 
 		return
 	}
@@ -203,6 +233,13 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientHadoukenResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -216,9 +253,9 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientHadoukenResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -239,12 +276,23 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientHadoukenResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientHadoukenResourceName, err)
 
 		return
 	}
@@ -253,6 +301,13 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientHadoukenResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -27,6 +27,7 @@ const downloadClientResourceName = "download_client"
 var (
 	_ resource.Resource                = &DownloadClientResource{}
 	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -45,7 +46,9 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // DownloadClient describes the download client data model.
@@ -94,6 +97,146 @@ type DownloadClient struct {
 	Enable               types.Bool   `tfsdk:"enable"`
 }
 
+// DownloadClientGeneric describes the generic download client resource data model.
+type DownloadClientGeneric struct {
+	Tags                 types.Set    `tfsdk:"tags"`
+	PostImTags           types.Set    `tfsdk:"post_im_tags"`
+	FieldTags            types.Set    `tfsdk:"field_tags"`
+	AdditionalTags       types.Set    `tfsdk:"additional_tags"`
+	Categories           types.Set    `tfsdk:"categories"`
+	NzbFolder            types.String `tfsdk:"nzb_folder"`
+	Category             types.String `tfsdk:"category"`
+	Implementation       types.String `tfsdk:"implementation"`
+	Name                 types.String `tfsdk:"name"`
+	Protocol             types.String `tfsdk:"protocol"`
+	MagnetFileExtension  types.String `tfsdk:"magnet_file_extension"`
+	TorrentFolder        types.String `tfsdk:"torrent_folder"`
+	StrmFolder           types.String `tfsdk:"strm_folder"`
+	Host                 types.String `tfsdk:"host"`
+	ConfigContract       types.String `tfsdk:"config_contract"`
+	Destination          types.String `tfsdk:"destination"`
+	Directory            types.String `tfsdk:"directory"`
+	TVDirectory          types.String `tfsdk:"station_directory"`
+	Username             types.String `tfsdk:"username"`
+	TvImportedCategory   types.String `tfsdk:"tv_imported_category"`
+	Password             types.String `tfsdk:"password"`
+	SecretToken          types.String `tfsdk:"secret_token"`
+	RPCPath              types.String `tfsdk:"rpc_path"`
+	URLBase              types.String `tfsdk:"url_base"`
+	APIKey               types.String `tfsdk:"api_key"`
+	APIURL               types.String `tfsdk:"api_url"`
+	AppID                types.String `tfsdk:"app_id"`
+	AppToken             types.String `tfsdk:"app_token"`
+	DestinationDirectory types.String `tfsdk:"destination_directory"`
+	ItemPriority         types.Int64  `tfsdk:"item_priority"`
+	IntialState          types.Int64  `tfsdk:"intial_state"`
+	InitialState         types.Int64  `tfsdk:"initial_state"`
+	Priority             types.Int64  `tfsdk:"priority"`
+	Port                 types.Int64  `tfsdk:"port"`
+	ID                   types.Int64  `tfsdk:"id"`
+	AddStopped           types.Bool   `tfsdk:"add_stopped"`
+	SaveMagnetFiles      types.Bool   `tfsdk:"save_magnet_files"`
+	ReadOnly             types.Bool   `tfsdk:"read_only"`
+	StartOnAdd           types.Bool   `tfsdk:"start_on_add"`
+	UseSsl               types.Bool   `tfsdk:"use_ssl"`
+	AddPaused            types.Bool   `tfsdk:"add_paused"`
+	Enable               types.Bool   `tfsdk:"enable"`
+	ForceSave            types.Bool   `tfsdk:"force_save"`
+	TestOnApply          types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (d DownloadClientGeneric) toDownloadClient() *DownloadClient {
+	return &DownloadClient{
+		Tags:                 d.Tags,
+		PostImTags:           d.PostImTags,
+		FieldTags:            d.FieldTags,
+		AdditionalTags:       d.AdditionalTags,
+		Categories:           d.Categories,
+		NzbFolder:            d.NzbFolder,
+		Category:             d.Category,
+		Implementation:       d.Implementation,
+		Name:                 d.Name,
+		Protocol:             d.Protocol,
+		MagnetFileExtension:  d.MagnetFileExtension,
+		TorrentFolder:        d.TorrentFolder,
+		StrmFolder:           d.StrmFolder,
+		Host:                 d.Host,
+		ConfigContract:       d.ConfigContract,
+		Destination:          d.Destination,
+		Directory:            d.Directory,
+		TVDirectory:          d.TVDirectory,
+		Username:             d.Username,
+		TvImportedCategory:   d.TvImportedCategory,
+		Password:             d.Password,
+		SecretToken:          d.SecretToken,
+		RPCPath:              d.RPCPath,
+		URLBase:              d.URLBase,
+		APIKey:               d.APIKey,
+		APIURL:               d.APIURL,
+		AppID:                d.AppID,
+		AppToken:             d.AppToken,
+		DestinationDirectory: d.DestinationDirectory,
+		ItemPriority:         d.ItemPriority,
+		IntialState:          d.IntialState,
+		InitialState:         d.InitialState,
+		Priority:             d.Priority,
+		Port:                 d.Port,
+		ID:                   d.ID,
+		AddStopped:           d.AddStopped,
+		SaveMagnetFiles:      d.SaveMagnetFiles,
+		ReadOnly:             d.ReadOnly,
+		StartOnAdd:           d.StartOnAdd,
+		UseSsl:               d.UseSsl,
+		AddPaused:            d.AddPaused,
+		Enable:               d.Enable,
+	}
+}
+
+func (d *DownloadClientGeneric) fromDownloadClient(client *DownloadClient) {
+	d.Tags = client.Tags
+	d.PostImTags = client.PostImTags
+	d.FieldTags = client.FieldTags
+	d.AdditionalTags = client.AdditionalTags
+	d.Categories = client.Categories
+	d.NzbFolder = client.NzbFolder
+	d.Category = client.Category
+	d.Implementation = client.Implementation
+	d.Name = client.Name
+	d.Protocol = client.Protocol
+	d.MagnetFileExtension = client.MagnetFileExtension
+	d.TorrentFolder = client.TorrentFolder
+	d.StrmFolder = client.StrmFolder
+	d.Host = client.Host
+	d.ConfigContract = client.ConfigContract
+	d.Destination = client.Destination
+	d.Directory = client.Directory
+	d.TVDirectory = client.TVDirectory
+	d.Username = client.Username
+	d.TvImportedCategory = client.TvImportedCategory
+	d.Password = client.Password
+	d.SecretToken = client.SecretToken
+	d.RPCPath = client.RPCPath
+	d.URLBase = client.URLBase
+	d.APIKey = client.APIKey
+	d.APIURL = client.APIURL
+	d.AppID = client.AppID
+	d.AppToken = client.AppToken
+	d.DestinationDirectory = client.DestinationDirectory
+	d.ItemPriority = client.ItemPriority
+	d.IntialState = client.IntialState
+	d.InitialState = client.InitialState
+	d.Priority = client.Priority
+	d.Port = client.Port
+	d.ID = client.ID
+	d.AddStopped = client.AddStopped
+	d.SaveMagnetFiles = client.SaveMagnetFiles
+	d.ReadOnly = client.ReadOnly
+	d.StartOnAdd = client.StartOnAdd
+	d.UseSsl = client.UseSsl
+	d.AddPaused = client.AddPaused
+	d.Enable = client.Enable
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -214,6 +357,14 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"add_paused": schema.BoolAttribute{
 				MarkdownDescription: "Add paused flag.",
@@ -417,14 +568,20 @@ func (r DownloadClientResource) getClientCategorySchema() schema.Schema {
 }
 
 func (r *DownloadClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *DownloadClientGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -432,28 +589,43 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Create, downloadClientResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.CreateDownloadClient(helpers.ContextWithForceSave(ctx)).DownloadClientResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, downloadClientResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client DownloadClient
+	var client DownloadClientGeneric
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -462,25 +634,22 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientResourceName, httpResp, err, resp)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
-
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, client)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *DownloadClient
+	var client *DownloadClientGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -488,23 +657,38 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &client.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, client.ForceSave.ValueBool(), helpers.Update, downloadClientResourceName, err) {
+		response, _, err = r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, downloadClientResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, client)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, downloadClientResourceName, client.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.DownloadClientApi.TestDownloadClient(ctx).DownloadClientResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *DownloadClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -533,6 +717,24 @@ func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.I
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
+func (d *DownloadClientGeneric) write(ctx context.Context, client *prowlarr.DownloadClientResource, diags *diag.Diagnostics) {
+	// this is needed because of many empty fields are unknown in both plan and read
+	var state DownloadClient
+
+	state.write(ctx, client, diags)
+
+	// prior secrets are kept, since Prowlarr returns them masked.
+	state.APIKey = helpers.UnmaskString(state.APIKey, d.APIKey)
+	state.AppToken = helpers.UnmaskString(state.AppToken, d.AppToken)
+	state.SecretToken = helpers.UnmaskString(state.SecretToken, d.SecretToken)
+	state.Password = helpers.UnmaskString(state.Password, d.Password)
+	d.fromDownloadClient(&state)
+}
+
+func (d *DownloadClientGeneric) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.DownloadClientResource {
+	return d.toDownloadClient().read(ctx, diags)
+}
+
 func (d *DownloadClient) write(ctx context.Context, downloadClient *prowlarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientSchemaDataSourceName = "download_client_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DownloadClientSchemaDataSource{}
+
+func NewDownloadClientSchemaDataSource() datasource.DataSource {
+	return &DownloadClientSchemaDataSource{}
+}
+
+// DownloadClientSchemaDataSource defines the download client schema implementation.
+type DownloadClientSchemaDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// DownloadClientSchema describes the download client schema data model.
+type DownloadClientSchema struct {
+	Fields             types.Set    `tfsdk:"fields"`
+	Implementation     types.String `tfsdk:"implementation"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+	InfoLink           types.String `tfsdk:"info_link"`
+	Protocol           types.String `tfsdk:"protocol"`
+	ID                 types.Int64  `tfsdk:"id"`
+}
+
+func (d *DownloadClientSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientSchemaDataSourceName
+}
+
+func (d *DownloadClientSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client schema definition, to be used with the generic [Download Client](../resources/download_client).",
+		Attributes: map[string]schema.Attribute{
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Download Client implementation name.",
+				Required:            true,
+			},
+			"implementation_name": schema.StringAttribute{
+				MarkdownDescription: "Download Client implementation display name.",
+				Computed:            true,
+			},
+			"config_contract": schema.StringAttribute{
+				MarkdownDescription: "Download Client configuration template.",
+				Computed:            true,
+			},
+			"info_link": schema.StringAttribute{
+				MarkdownDescription: "Link to the download client documentation.",
+				Computed:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol. Valid values are 'usenet' and 'torrent'.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Schema ID.",
+				Computed:            true,
+			},
+			"fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of configuration fields.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IndexerSchemaDataSource{}.getFieldSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DownloadClientSchema
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get download client schemas current value
+	response, httpResp, err := d.client.DownloadClientApi.ListDownloadClientSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSchemaDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Implementation.ValueString(), response, httpResp, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+downloadClientSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *DownloadClientSchema) find(ctx context.Context, implementation string, schemas []*prowlarr.DownloadClientResource, httpResp *http.Response, diags *diag.Diagnostics) {
+	for id, item := range schemas {
+		if item.GetImplementation() == implementation {
+			s.ID = types.Int64Value(int64(id))
+			s.write(ctx, item, decodeSchemaFieldPrivacy(httpResp, id, downloadClientSchemaDataSourceName, diags), diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(downloadClientSchemaDataSourceName, "implementation", implementation))
+}
+
+func (s *DownloadClientSchema) write(ctx context.Context, item *prowlarr.DownloadClientResource, fieldPrivacy map[string]string, diags *diag.Diagnostics) {
+	s.Implementation = types.StringValue(item.GetImplementation())
+	s.ImplementationName = types.StringValue(item.GetImplementationName())
+	s.ConfigContract = types.StringValue(item.GetConfigContract())
+	s.InfoLink = types.StringValue(item.GetInfoLink())
+	s.Protocol = types.StringValue(string(item.GetProtocol()))
+	s.Fields = writeSchemaFields(ctx, item.GetFields(), fieldPrivacy, diags)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDownloadClientSchemaDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccDownloadClientSchemaDataSourceConfig("error"),
+				ExpectError: regexp.MustCompile("Unable to find download_client_schema"),
+			},
+			// Read testing
+			{
+				Config: testAccDownloadClientSchemaDataSourceConfig("Transmission"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_download_client_schema.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_download_client_schema.test", "implementation", "Transmission"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_download_client_schema.test", "fields.*", map[string]string{"name": "host"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDownloadClientSchemaDataSourceConfig(implementation string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_download_client_schema" "test" {
+		implementation = "%s"
+	}
+	`, implementation)
+}
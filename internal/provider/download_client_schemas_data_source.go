@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientSchemasDataSourceName = "download_client_schemas"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DownloadClientSchemasDataSource{}
+
+func NewDownloadClientSchemasDataSource() datasource.DataSource {
+	return &DownloadClientSchemasDataSource{}
+}
+
+// DownloadClientSchemasDataSource defines the download client schemas implementation.
+type DownloadClientSchemasDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// DownloadClientSchemas describes the download client schemas data model.
+type DownloadClientSchemas struct {
+	DownloadClientSchemas types.List   `tfsdk:"download_client_schemas"`
+	ID                    types.String `tfsdk:"id"`
+}
+
+func (d *DownloadClientSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientSchemasDataSourceName
+}
+
+func (d *DownloadClientSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Download Clients -->List all available [Download Client Schemas](../data-sources/download_client_schema).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"download_client_schemas": schema.ListAttribute{
+				MarkdownDescription: "Download Client implementation list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DownloadClientSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *DownloadClientSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get download client schemas current value
+	response, _, err := d.client.DownloadClientApi.ListDownloadClientSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSchemasDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientSchemasDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]string, len(response))
+	for i, s := range response {
+		schemas[i] = s.GetImplementation()
+	}
+
+	schemaList, diags := types.ListValueFrom(ctx, types.StringType, schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClientSchemas{DownloadClientSchemas: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -418,7 +418,7 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create new Host
 	response, _, err := r.client.HostConfigApi.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, hostResourceName, err)
 
 		return
 	}
@@ -469,7 +469,7 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update Host
 	response, _, err := r.client.HostConfigApi.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, hostResourceName, err)
 
 		return
 	}
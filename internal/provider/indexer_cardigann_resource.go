@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerCardigannResourceName   = "indexer_cardigann"
+	indexerCardigannImplementation = "Cardigann"
+	indexerCardigannConfigContract = "CardigannSettings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerCardigannResource{}
+	_ resource.ResourceWithImportState = &IndexerCardigannResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerCardigannResource{}
+)
+
+func NewIndexerCardigannResource() resource.Resource {
+	return &IndexerCardigannResource{}
+}
+
+// IndexerCardigannResource defines the indexer implementation.
+type IndexerCardigannResource struct {
+	client       *prowlarr.APIClient
+	defaultTags  *helpers.DefaultTags
+	testOnApply  bool
+	providerData *helpers.ProviderData
+}
+
+// IndexerCardigann describes the indexer data model.
+type IndexerCardigann struct {
+	Tags              types.Set    `tfsdk:"tags"`
+	Settings          types.Map    `tfsdk:"settings"`
+	SensitiveSettings types.Map    `tfsdk:"sensitive_settings"`
+	AllFields         types.Set    `tfsdk:"all_fields"`
+	Definition        types.String `tfsdk:"definition"`
+	ConfigContract    types.String `tfsdk:"config_contract"`
+	Implementation    types.String `tfsdk:"implementation"`
+	Name              types.String `tfsdk:"name"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Language          types.String `tfsdk:"language"`
+	Privacy           types.String `tfsdk:"privacy"`
+	AppProfileID      types.Int64  `tfsdk:"app_profile_id"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	ID                types.Int64  `tfsdk:"id"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	ForceSave         types.Bool   `tfsdk:"force_save"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i IndexerCardigann) toIndexer() *Indexer {
+	return &Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		Protocol:       i.Protocol,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Fields:         types.SetNull(IndexerResource{}.getFieldSchema().Type()),
+		AllFields:      i.AllFields,
+		ConfigContract: types.StringValue(indexerCardigannConfigContract),
+		Implementation: types.StringValue(indexerCardigannImplementation),
+	}
+}
+
+func (i *IndexerCardigann) fromIndexer(indexer *Indexer) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.Protocol = indexer.Protocol
+	i.ConfigContract = indexer.ConfigContract
+	i.Implementation = indexer.Implementation
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+	i.AllFields = indexer.AllFields
+}
+
+func (r *IndexerCardigannResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerCardigannResourceName
+}
+
+func (r *IndexerCardigannResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Cardigann resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) and [Cardigann](https://wiki.servarr.com/prowlarr/cardigann-yml-definition).",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"definition": schema.StringAttribute{
+				MarkdownDescription: "Cardigann definition name (e.g. `1337x`), as in the `definitionFile` field of the `prowlarr_indexer_schema` data source.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"config_contract": schema.StringAttribute{
+				MarkdownDescription: "Indexer configuration template.",
+				Computed:            true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Indexer implementation name.",
+				Computed:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol, resolved from the definition.",
+				Computed:            true,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy, resolved from the definition.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Map of definition settings by field name (e.g. `baseUrl`), validated against the definition schema. Lists are comma separated (e.g. `\"1,5\"`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"sensitive_settings": schema.MapAttribute{
+				MarkdownDescription: "Map of sensitive definition settings by field name (e.g. `password`), same as `settings`.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"all_fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of all the configuration fields returned by Prowlarr, including the ones not configured.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IndexerResource{}.getAllFieldSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *IndexerCardigannResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+		r.providerData = data
+	}
+}
+
+func (r *IndexerCardigannResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+
+	// Nothing to validate on destroy or without a configured provider.
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDuplicateSettings(indexer.Settings, indexer.SensitiveSettings, &resp.Diagnostics)
+
+	if indexer.Definition.IsUnknown() {
+		return
+	}
+
+	// validation is skipped if schemas are not reachable, errors will be reported on apply.
+	schemas, err := r.providerData.IndexerSchemas(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate indexer definition", helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return
+	}
+
+	definition := indexer.findSchema(schemas, &resp.Diagnostics)
+	if definition == nil {
+		return
+	}
+
+	for _, settings := range []struct {
+		value types.Map
+		name  string
+	}{{indexer.Settings, "settings"}, {indexer.SensitiveSettings, "sensitive_settings"}} {
+		for _, message := range helpers.ValidateSettings(settings.value, definition.GetFields()) {
+			resp.Diagnostics.AddAttributeError(path.Root(settings.name), "Invalid Indexer Setting", message)
+		}
+	}
+
+	// Fill the values defined by the definition.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("implementation"), definition.GetImplementation())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("config_contract"), definition.GetConfigContract())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("protocol"), string(definition.GetProtocol()))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("privacy"), string(definition.GetPrivacy()))...)
+}
+
+func (r *IndexerCardigannResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerCardigann
+	definition := r.definition(ctx, indexer, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, definition, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Create, indexerCardigannResourceName, err) {
+		response, _, err = r.client.IndexerApi.CreateIndexer(helpers.ContextWithForceSave(ctx)).IndexerResource(*request).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerCardigannResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerCardigannResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerCardigannResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerCardigann current value
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerCardigannResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerCardigannResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the fields not managed by Terraform
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("all_fields"), &indexer.AllFields)...)
+
+	// Update IndexerCardigann
+	definition := r.definition(ctx, indexer, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, definition, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Update, indexerCardigannResourceName, err) {
+		response, _, err = r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).ForceSave(true).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerCardigannResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerCardigannResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerCardigannResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerCardigann current value
+	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerCardigannResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerCardigannResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerCardigannResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+indexerCardigannResourceName+": "+req.ID)
+}
+
+// definition returns the schema of the configured definition.
+func (r *IndexerCardigannResource) definition(ctx context.Context, indexer *IndexerCardigann, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	schemas, err := r.providerData.IndexerSchemas(ctx)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return nil
+	}
+
+	return indexer.findSchema(schemas, diags)
+}
+
+// findSchema returns the schema of the configured definition, suggesting the closest definition if missing.
+func (i *IndexerCardigann) findSchema(schemas []*prowlarr.IndexerResource, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	definition := i.Definition.ValueString()
+
+	schema := helpers.FindIndexerSchema(schemas, indexerCardigannImplementation, indexerCardigannConfigContract, definition)
+	if schema != nil {
+		return schema
+	}
+
+	var definitions []string
+
+	for _, s := range schemas {
+		for _, f := range s.GetFields() {
+			if value, ok := f.GetValue().(string); ok && f.GetName() == helpers.DefinitionFileField {
+				definitions = append(definitions, value)
+			}
+		}
+	}
+
+	detail := fmt.Sprintf("No Cardigann definition named '%s' found.", definition)
+	if match := helpers.ClosestMatch(definition, definitions); match != "" {
+		detail += fmt.Sprintf(" Did you mean '%s'?", match)
+	}
+
+	diags.AddAttributeError(path.Root("definition"), "Unknown Indexer Definition", detail)
+
+	return nil
+}
+
+func (i *IndexerCardigann) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+
+	// the definition is kept if missing, to avoid a replacement.
+	for _, f := range indexer.GetFields() {
+		if definition, ok := f.GetValue().(string); ok && f.GetName() == helpers.DefinitionFileField {
+			i.Definition = types.StringValue(definition)
+		}
+	}
+
+	i.Settings = helpers.WriteSettings(ctx, indexer.GetFields(), i.Settings, diags)
+	i.SensitiveSettings = helpers.WriteSettings(ctx, indexer.GetFields(), i.SensitiveSettings, diags)
+}
+
+func (i *IndexerCardigann) read(ctx context.Context, definition *prowlarr.IndexerResource, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	generic := i.toIndexer()
+	generic.Protocol = types.StringValue(string(definition.GetProtocol()))
+
+	indexer := generic.read(ctx, diags)
+	definitionFile := prowlarr.NewField()
+	definitionFile.SetName(helpers.DefinitionFileField)
+	definitionFile.SetValue(i.Definition.ValueString())
+	indexer.Fields = helpers.MergeFields(indexer.Fields, []*prowlarr.Field{definitionFile})
+	indexer.Fields = helpers.MergeFields(indexer.Fields, helpers.ReadSettings(ctx, i.Settings, definition.GetFields(), diags))
+	indexer.Fields = helpers.MergeFields(indexer.Fields, helpers.ReadSettings(ctx, i.SensitiveSettings, definition.GetFields(), diags))
+
+	return indexer
+}
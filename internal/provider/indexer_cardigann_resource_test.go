@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerCardigannResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerCardigannResourceConfig("resourceCardigannTest", "0.5") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Unknown definition
+			{
+				Config:      `resource "prowlarr_indexer_cardigann" "test" { name = "error" definition = "0magnett" }`,
+				ExpectError: regexp.MustCompile("Did you mean '0magnet'"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerCardigannResourceConfig("resourceCardigannTest", "0.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "protocol", "torrent"),
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "implementation", "Cardigann"),
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "settings.torrentBaseSettings.seedRatio", "0.5"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_cardigann.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerCardigannResourceConfig("resourceCardigannTest", "0.5") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerCardigannResourceConfig("resourceCardigannTest", "0.7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "settings.torrentBaseSettings.seedRatio", "0.7"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "prowlarr_indexer_cardigann.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexerCardigannResourcePartialSettings(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the seed ratio
+			{
+				Config: testAccIndexerCardigannResourceConfig("partialCardigannTest", "0.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer_cardigann.test", "all_fields.*", map[string]string{"name": "torrentBaseSettings.seedRatio", "number_value": "0.5"}),
+				),
+			},
+			// Update without the seed ratio, which is kept
+			{
+				Config: testAccIndexerCardigannResourcePartialConfig("partialCardigannTestUpdated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "name", "partialCardigannTestUpdated"),
+					resource.TestCheckNoResourceAttr("prowlarr_indexer_cardigann.test", "settings.torrentBaseSettings.seedRatio"),
+					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer_cardigann.test", "all_fields.*", map[string]string{"name": "torrentBaseSettings.seedRatio", "number_value": "0.5"}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerCardigannResourceConfig(name, ratio string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_cardigann" "test" {
+		enable = false
+		name = "%s"
+		definition = "0magnet"
+		settings = {
+			"baseUrl" = "https://0magnet.com/"
+			"torrentBaseSettings.seedRatio" = "%s"
+		}
+	}`, name, ratio)
+}
+
+func testAccIndexerCardigannResourcePartialConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_cardigann" "test" {
+		enable = false
+		name = "%s"
+		definition = "0magnet"
+		settings = {
+			"baseUrl" = "https://0magnet.com/"
+		}
+	}`, name)
+}
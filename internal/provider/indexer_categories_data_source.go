@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerCategoriesDataSourceName = "indexer_categories"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerCategoriesDataSource{}
+
+func NewIndexerCategoriesDataSource() datasource.DataSource {
+	return &IndexerCategoriesDataSource{}
+}
+
+// IndexerCategoriesDataSource defines the indexer categories implementation.
+type IndexerCategoriesDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// IndexerCategories describes the indexer categories data model.
+type IndexerCategories struct {
+	Categories types.Set    `tfsdk:"categories"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// IndexerCategory describes a single indexer category.
+type IndexerCategory struct {
+	SubCategories types.Set    `tfsdk:"sub_categories"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	ID            types.Int64  `tfsdk:"id"`
+	ParentID      types.Int64  `tfsdk:"parent_id"`
+}
+
+func (c IndexerCategory) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"sub_categories": types.SetType{}.WithElementType(types.Int64Type),
+			"name":           types.StringType,
+			"description":    types.StringType,
+			"id":             types.Int64Type,
+			"parent_id":      types.Int64Type,
+		})
+}
+
+func (d *IndexerCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerCategoriesDataSourceName
+}
+
+func (d *IndexerCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->List all available indexer categories, to be used as applications `sync_categories`.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"categories": schema.SetNestedAttribute{
+				MarkdownDescription: "Indexer categories, including the subcategories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Category ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Category name.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Category description.",
+							Computed:            true,
+						},
+						"parent_id": schema.Int64Attribute{
+							MarkdownDescription: "Parent category ID, null for the main categories.",
+							Computed:            true,
+						},
+						"sub_categories": schema.SetAttribute{
+							MarkdownDescription: "Subcategory IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *IndexerCategoriesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get indexer categories current value
+	response, _, err := d.client.IndexerDefaultCategoriesApi.ListIndexerCategories(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerCategoriesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerCategoriesDataSourceName)
+	// Map response body to resource schema attribute
+	categories := writeIndexerCategories(ctx, response, types.Int64Null(), &resp.Diagnostics)
+
+	var tempDiag diag.Diagnostics
+
+	data := IndexerCategories{}
+	data.Categories, tempDiag = types.SetValueFrom(ctx, IndexerCategory{}.getType(), categories)
+	resp.Diagnostics.Append(tempDiag...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.StringValue(strconv.Itoa(len(categories)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// writeIndexerCategories flattens the category tree, linking each category to its parent.
+func writeIndexerCategories(ctx context.Context, categories []*prowlarr.IndexerCategory, parentID types.Int64, diags *diag.Diagnostics) []IndexerCategory {
+	list := make([]IndexerCategory, 0, len(categories))
+
+	for _, c := range categories {
+		var tempDiag diag.Diagnostics
+
+		id := types.Int64Value(int64(c.GetId()))
+		subCategories := make([]int64, len(c.GetSubCategories()))
+
+		for i, s := range c.GetSubCategories() {
+			subCategories[i] = int64(s.GetId())
+		}
+
+		category := IndexerCategory{
+			ID:          id,
+			ParentID:    parentID,
+			Name:        types.StringValue(c.GetName()),
+			Description: types.StringValue(c.GetDescription()),
+		}
+		category.SubCategories, tempDiag = types.SetValueFrom(ctx, types.Int64Type, subCategories)
+		diags.Append(tempDiag...)
+
+		list = append(list, category)
+		list = append(list, writeIndexerCategories(ctx, c.GetSubCategories(), id, diags)...)
+	}
+
+	return list
+}
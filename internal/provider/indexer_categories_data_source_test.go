@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerCategoriesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerCategoriesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerCategoriesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_indexer_categories.test", "categories.*", map[string]string{
+						"id":   "5070",
+						"name": "TV/Anime",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_indexer_categories.test", "categories.*", map[string]string{
+						"id":   "5000",
+						"name": "TV",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccIndexerCategoriesDataSourceConfig = `
+data "prowlarr_indexer_categories" "test" {
+}
+`
@@ -76,6 +76,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: "Privacy.",
 				Computed:            true,
 			},
+			"indexer_urls": schema.SetAttribute{
+				MarkdownDescription: "Available indexer URLs.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -35,6 +35,7 @@ func TestAccIndexerDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.prowlarr_indexer.test", "id"),
 					resource.TestCheckResourceAttr("data.prowlarr_indexer.test", "name", "DataSourceTest"),
+					resource.TestCheckResourceAttrSet("data.prowlarr_indexer.test", "indexer_urls.#"),
 				),
 			},
 		},
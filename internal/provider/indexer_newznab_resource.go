@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerNewznabResourceName   = "indexer_newznab"
+	indexerNewznabImplementation = "Newznab"
+	indexerNewznabConfigContract = "NewznabSettings"
+	indexerNewznabProtocol       = "usenet"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerNewznabResource{}
+	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNewznabResource{}
+)
+
+var indexerNewznabFields = helpers.Fields{
+	Strings:   []string{"baseUrl", "apiPath", "apiKey", "additionalParameters"},
+	IntSlices: []string{"categories"},
+}
+
+func NewIndexerNewznabResource() resource.Resource {
+	return &IndexerNewznabResource{}
+}
+
+// IndexerNewznabResource defines the indexer implementation.
+type IndexerNewznabResource struct {
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
+}
+
+// IndexerNewznab describes the indexer data model.
+type IndexerNewznab struct {
+	Tags                 types.Set    `tfsdk:"tags"`
+	Categories           types.Set    `tfsdk:"categories"`
+	Name                 types.String `tfsdk:"name"`
+	Language             types.String `tfsdk:"language"`
+	Privacy              types.String `tfsdk:"privacy"`
+	BaseURL              types.String `tfsdk:"base_url"`
+	APIPath              types.String `tfsdk:"api_path"`
+	APIKey               types.String `tfsdk:"api_key"`
+	AdditionalParameters types.String `tfsdk:"additional_parameters"`
+	AppProfileID         types.Int64  `tfsdk:"app_profile_id"`
+	Priority             types.Int64  `tfsdk:"priority"`
+	ID                   types.Int64  `tfsdk:"id"`
+	Enable               types.Bool   `tfsdk:"enable"`
+	ForceSave            types.Bool   `tfsdk:"force_save"`
+	TestOnApply          types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i IndexerNewznab) toIndexer() *Indexer {
+	return &Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Fields:         types.SetNull(IndexerResource{}.getFieldSchema().Type()),
+		ConfigContract: types.StringValue(indexerNewznabConfigContract),
+		Implementation: types.StringValue(indexerNewznabImplementation),
+		Protocol:       types.StringValue(indexerNewznabProtocol),
+	}
+}
+
+func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+}
+
+func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerNewznabResourceName
+}
+
+func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) and [Newznab](https://wiki.servarr.com/prowlarr/supported#newznab).",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
+			// Field values
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL.",
+				Required:            true,
+			},
+			"api_path": schema.StringAttribute{
+				MarkdownDescription: "API path.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"additional_parameters": schema.StringAttribute{
+				MarkdownDescription: "Additional parameters.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Categories.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+	}
+}
+
+func (r *IndexerNewznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
+func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerNewznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Create, indexerNewznabResourceName, err) {
+		response, _, err = r.client.IndexerApi.CreateIndexer(helpers.ContextWithForceSave(ctx)).IndexerResource(*request).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerNewznabResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerNewznabResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerNewznab current value
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerNewznabResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerNewznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update IndexerNewznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Update, indexerNewznabResourceName, err) {
+		response, _, err = r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).ForceSave(true).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerNewznabResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerNewznabResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerNewznab current value
+	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNewznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerNewznabResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
+}
+
+func (i *IndexerNewznab) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+	i.Categories = types.SetValueMust(types.Int64Type, nil)
+	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerNewznabFields)
+}
+
+func (i *IndexerNewznab) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	indexer := i.toIndexer().read(ctx, diags)
+	indexer.SetFields(helpers.ReadFields(ctx, i, indexerNewznabFields))
+
+	return indexer
+}
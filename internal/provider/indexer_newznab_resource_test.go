@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerNewznabResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerNewznabResourceConfig("resourceNewznabTest", "/api") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerNewznabResourceConfig("resourceNewznabTest", "/api"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_newznab.test", "api_path", "/api"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_newznab.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerNewznabResourceConfig("resourceNewznabTest", "/api") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerNewznabResourceConfig("resourceNewznabTest", "/api/v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_newznab.test", "api_path", "/api/v2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "prowlarr_indexer_newznab.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerNewznabResourceConfig(name, path string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_newznab" "test" {
+		enable = false
+		name = "%s"
+		base_url = "https://example.newznab.com"
+		api_path = "%s"
+		api_key = "Key"
+		categories = [2000]
+		force_save = true
+	}`, name, path)
+}
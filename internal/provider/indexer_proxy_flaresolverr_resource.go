@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxyFlaresolverrResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyFlaresolverrResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyFlaresolverrResource{}
 )
 
 func NewIndexerProxyFlaresolverrResource() resource.Resource {
@@ -35,7 +36,9 @@ func NewIndexerProxyFlaresolverrResource() resource.Resource {
 
 // IndexerProxyFlaresolverrResource defines the indexer proxy implementation.
 type IndexerProxyFlaresolverrResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // IndexerProxyFlaresolverr describes the indexer proxy data model.
@@ -45,6 +48,8 @@ type IndexerProxyFlaresolverr struct {
 	Host           types.String `tfsdk:"host"`
 	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
 	ID             types.Int64  `tfsdk:"id"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerProxyFlaresolverr) toIndexerProxy() *IndexerProxy {
@@ -92,6 +97,14 @@ func (r *IndexerProxyFlaresolverrResource) Schema(_ context.Context, _ resource.
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"request_timeout": schema.Int64Attribute{
 				MarkdownDescription: "Request timeout.",
@@ -106,11 +119,17 @@ func (r *IndexerProxyFlaresolverrResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *IndexerProxyFlaresolverrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *IndexerProxyFlaresolverrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxyFlaresolverr
@@ -121,12 +140,23 @@ func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.CreateIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Create, indexerProxyFlaresolverrResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.CreateIndexerProxy(helpers.ContextWithForceSave(ctx)).IndexerProxyResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyFlaresolverrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerProxyFlaresolverrResourceName, err)
 
 		return
 	}
@@ -135,6 +165,13 @@ func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resou
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyFlaresolverrResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxyFlaresolverrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -148,9 +185,9 @@ func (r *IndexerProxyFlaresolverrResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get IndexerProxyFlaresolverr current value
-	response, _, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyFlaresolverrResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyFlaresolverrResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -171,12 +208,23 @@ func (r *IndexerProxyFlaresolverrResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Update, indexerProxyFlaresolverrResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyFlaresolverrResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerProxyFlaresolverrResourceName, err)
 
 		return
 	}
@@ -185,6 +233,13 @@ func (r *IndexerProxyFlaresolverrResource) Update(ctx context.Context, req resou
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyFlaresolverrResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxyFlaresolverrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
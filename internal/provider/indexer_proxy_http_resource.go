@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxyHTTPResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyHTTPResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyHTTPResource{}
 )
 
 func NewIndexerProxyHTTPResource() resource.Resource {
@@ -35,18 +36,22 @@ func NewIndexerProxyHTTPResource() resource.Resource {
 
 // IndexerProxyHTTPResource defines the indexer proxy implementation.
 type IndexerProxyHTTPResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // IndexerProxyHTTP describes the indexer proxy data model.
 type IndexerProxyHTTP struct {
-	Tags     types.Set    `tfsdk:"tags"`
-	Name     types.String `tfsdk:"name"`
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Port     types.Int64  `tfsdk:"port"`
-	ID       types.Int64  `tfsdk:"id"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Name        types.String `tfsdk:"name"`
+	Host        types.String `tfsdk:"host"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Port        types.Int64  `tfsdk:"port"`
+	ID          types.Int64  `tfsdk:"id"`
+	ForceSave   types.Bool   `tfsdk:"force_save"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerProxyHTTP) toIndexerProxy() *IndexerProxy {
@@ -98,6 +103,14 @@ func (r *IndexerProxyHTTPResource) Schema(_ context.Context, _ resource.SchemaRe
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "Port.",
@@ -121,11 +134,17 @@ func (r *IndexerProxyHTTPResource) Schema(_ context.Context, _ resource.SchemaRe
 }
 
 func (r *IndexerProxyHTTPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *IndexerProxyHTTPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyHTTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxyHTTP
@@ -136,12 +155,23 @@ func (r *IndexerProxyHTTPResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerProxyHTTP
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.CreateIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Create, indexerProxyHTTPResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.CreateIndexerProxy(helpers.ContextWithForceSave(ctx)).IndexerProxyResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyHTTPResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerProxyHTTPResourceName, err)
 
 		return
 	}
@@ -150,6 +180,13 @@ func (r *IndexerProxyHTTPResource) Create(ctx context.Context, req resource.Crea
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyHTTPResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxyHTTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -163,9 +200,9 @@ func (r *IndexerProxyHTTPResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get IndexerProxyHTTP current value
-	response, _, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyHTTPResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyHTTPResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -186,12 +223,23 @@ func (r *IndexerProxyHTTPResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerProxyHTTP
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Update, indexerProxyHTTPResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyHTTPResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerProxyHTTPResourceName, err)
 
 		return
 	}
@@ -200,6 +248,13 @@ func (r *IndexerProxyHTTPResource) Update(ctx context.Context, req resource.Upda
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyHTTPResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxyHTTPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
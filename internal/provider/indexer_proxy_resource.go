@@ -24,6 +24,7 @@ const indexerProxyResourceName = "indexer_proxy"
 var (
 	_ resource.Resource                = &IndexerProxyResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyResource{}
 )
 
 var indexerProxyFields = helpers.Fields{
@@ -37,7 +38,9 @@ func NewIndexerProxyResource() resource.Resource {
 
 // IndexerProxyResource defines the indexer proxy implementation.
 type IndexerProxyResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // IndexerProxy describes the indexer proxy data model.
@@ -54,6 +57,50 @@ type IndexerProxy struct {
 	ID             types.Int64  `tfsdk:"id"`
 }
 
+// IndexerProxyGeneric describes the generic indexer proxy resource data model.
+type IndexerProxyGeneric struct {
+	Tags           types.Set    `tfsdk:"tags"`
+	Name           types.String `tfsdk:"name"`
+	ConfigContract types.String `tfsdk:"config_contract"`
+	Implementation types.String `tfsdk:"implementation"`
+	Host           types.String `tfsdk:"host"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Port           types.Int64  `tfsdk:"port"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	ID             types.Int64  `tfsdk:"id"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i IndexerProxyGeneric) toIndexerProxy() *IndexerProxy {
+	return &IndexerProxy{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		ConfigContract: i.ConfigContract,
+		Implementation: i.Implementation,
+		Host:           i.Host,
+		Username:       i.Username,
+		Password:       i.Password,
+		Port:           i.Port,
+		RequestTimeout: i.RequestTimeout,
+		ID:             i.ID,
+	}
+}
+
+func (i *IndexerProxyGeneric) fromIndexerProxy(proxy *IndexerProxy) {
+	i.Tags = proxy.Tags
+	i.Name = proxy.Name
+	i.ConfigContract = proxy.ConfigContract
+	i.Implementation = proxy.Implementation
+	i.Host = proxy.Host
+	i.Username = proxy.Username
+	i.Password = proxy.Password
+	i.Port = proxy.Port
+	i.RequestTimeout = proxy.RequestTimeout
+	i.ID = proxy.ID
+}
+
 func (i IndexerProxy) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -103,6 +150,14 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "Port.",
@@ -135,14 +190,20 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *IndexerProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *IndexerProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var proxy *IndexerProxy
+	var proxy *IndexerProxyGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -150,28 +211,43 @@ func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.CreateIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Create, indexerProxyResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.CreateIndexerProxy(helpers.ContextWithForceSave(ctx)).IndexerProxyResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerProxyResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	proxy.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var proxy IndexerProxy
+	var proxy IndexerProxyGeneric
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &proxy)...)
 
@@ -180,25 +256,22 @@ func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get IndexerProxy current value
-	response, _, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyResourceName, httpResp, err, resp)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
-
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	proxy.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, proxy)...)
 }
 
 func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var proxy *IndexerProxy
+	var proxy *IndexerProxyGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -206,23 +279,38 @@ func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Update, indexerProxyResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerProxyResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	proxy.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxyResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *IndexerProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -251,6 +339,18 @@ func (r *IndexerProxyResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+indexerProxyResourceName+": "+req.ID)
 }
 
+func (i *IndexerProxyGeneric) write(ctx context.Context, proxy *prowlarr.IndexerProxyResource, diags *diag.Diagnostics) {
+	// this is needed because of many empty fields are unknown in both plan and read
+	var state IndexerProxy
+
+	state.write(ctx, proxy, diags)
+	i.fromIndexerProxy(&state)
+}
+
+func (i *IndexerProxyGeneric) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerProxyResource {
+	return i.toIndexerProxy().read(ctx, diags)
+}
+
 func (i *IndexerProxy) write(ctx context.Context, indexerProxy *prowlarr.IndexerProxyResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerProxySchemaDataSourceName = "indexer_proxy_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerProxySchemaDataSource{}
+
+func NewIndexerProxySchemaDataSource() datasource.DataSource {
+	return &IndexerProxySchemaDataSource{}
+}
+
+// IndexerProxySchemaDataSource defines the indexer proxy schema implementation.
+type IndexerProxySchemaDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// IndexerProxySchema describes the indexer proxy schema data model.
+type IndexerProxySchema struct {
+	Fields             types.Set    `tfsdk:"fields"`
+	Implementation     types.String `tfsdk:"implementation"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+	InfoLink           types.String `tfsdk:"info_link"`
+	ID                 types.Int64  `tfsdk:"id"`
+}
+
+func (d *IndexerProxySchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerProxySchemaDataSourceName
+}
+
+func (d *IndexerProxySchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->Indexer Proxy schema definition, to be used with the generic [Indexer Proxy](../resources/indexer_proxy).",
+		Attributes: map[string]schema.Attribute{
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Indexer Proxy implementation name.",
+				Required:            true,
+			},
+			"implementation_name": schema.StringAttribute{
+				MarkdownDescription: "Indexer Proxy implementation display name.",
+				Computed:            true,
+			},
+			"config_contract": schema.StringAttribute{
+				MarkdownDescription: "Indexer Proxy configuration template.",
+				Computed:            true,
+			},
+			"info_link": schema.StringAttribute{
+				MarkdownDescription: "Link to the indexer proxy documentation.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Schema ID.",
+				Computed:            true,
+			},
+			"fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of configuration fields.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IndexerSchemaDataSource{}.getFieldSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerProxySchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *IndexerProxySchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IndexerProxySchema
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get indexer proxy schemas current value
+	response, httpResp, err := d.client.IndexerProxyApi.ListIndexerProxySchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxySchemaDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Implementation.ValueString(), response, httpResp, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+indexerProxySchemaDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *IndexerProxySchema) find(ctx context.Context, implementation string, schemas []*prowlarr.IndexerProxyResource, httpResp *http.Response, diags *diag.Diagnostics) {
+	for id, item := range schemas {
+		if item.GetImplementation() == implementation {
+			s.ID = types.Int64Value(int64(id))
+			s.write(ctx, item, decodeSchemaFieldPrivacy(httpResp, id, indexerProxySchemaDataSourceName, diags), diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(indexerProxySchemaDataSourceName, "implementation", implementation))
+}
+
+func (s *IndexerProxySchema) write(ctx context.Context, item *prowlarr.IndexerProxyResource, fieldPrivacy map[string]string, diags *diag.Diagnostics) {
+	s.Implementation = types.StringValue(item.GetImplementation())
+	s.ImplementationName = types.StringValue(item.GetImplementationName())
+	s.ConfigContract = types.StringValue(item.GetConfigContract())
+	s.InfoLink = types.StringValue(item.GetInfoLink())
+	s.Fields = writeSchemaFields(ctx, item.GetFields(), fieldPrivacy, diags)
+}
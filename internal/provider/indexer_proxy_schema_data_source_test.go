@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerProxySchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerProxySchemaDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccIndexerProxySchemaDataSourceConfig("error"),
+				ExpectError: regexp.MustCompile("Unable to find indexer_proxy_schema"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerProxySchemaDataSourceConfig("FlareSolverr"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_indexer_proxy_schema.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_indexer_proxy_schema.test", "implementation", "FlareSolverr"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_indexer_proxy_schema.test", "fields.*", map[string]string{"name": "host"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccIndexerProxySchemaDataSourceConfig(implementation string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_indexer_proxy_schema" "test" {
+		implementation = "%s"
+	}
+	`, implementation)
+}
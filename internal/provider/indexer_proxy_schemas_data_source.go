@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerProxySchemasDataSourceName = "indexer_proxy_schemas"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerProxySchemasDataSource{}
+
+func NewIndexerProxySchemasDataSource() datasource.DataSource {
+	return &IndexerProxySchemasDataSource{}
+}
+
+// IndexerProxySchemasDataSource defines the indexer proxy schemas implementation.
+type IndexerProxySchemasDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// IndexerProxySchemas describes the indexer proxy schemas data model.
+type IndexerProxySchemas struct {
+	IndexerProxySchemas types.List   `tfsdk:"indexer_proxy_schemas"`
+	ID                  types.String `tfsdk:"id"`
+}
+
+func (d *IndexerProxySchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerProxySchemasDataSourceName
+}
+
+func (d *IndexerProxySchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexer Proxies -->List all available [Indexer Proxy Schemas](../data-sources/indexer_proxy_schema).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"indexer_proxy_schemas": schema.ListAttribute{
+				MarkdownDescription: "Indexer Proxy implementation list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *IndexerProxySchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *IndexerProxySchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get indexer proxy schemas current value
+	response, _, err := d.client.IndexerProxyApi.ListIndexerProxySchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxySchemasDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerProxySchemasDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]string, len(response))
+	for i, s := range response {
+		schemas[i] = s.GetImplementation()
+	}
+
+	schemaList, diags := types.ListValueFrom(ctx, types.StringType, schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerProxySchemas{IndexerProxySchemas: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
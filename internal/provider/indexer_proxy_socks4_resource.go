@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxySocks4Resource{}
 	_ resource.ResourceWithImportState = &IndexerProxySocks4Resource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxySocks4Resource{}
 )
 
 func NewIndexerProxySocks4Resource() resource.Resource {
@@ -35,18 +36,22 @@ func NewIndexerProxySocks4Resource() resource.Resource {
 
 // IndexerProxySocks4Resource defines the indexer proxy implementation.
 type IndexerProxySocks4Resource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // IndexerProxySocks4 describes the indexer proxy data model.
 type IndexerProxySocks4 struct {
-	Tags     types.Set    `tfsdk:"tags"`
-	Name     types.String `tfsdk:"name"`
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Port     types.Int64  `tfsdk:"port"`
-	ID       types.Int64  `tfsdk:"id"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Name        types.String `tfsdk:"name"`
+	Host        types.String `tfsdk:"host"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Port        types.Int64  `tfsdk:"port"`
+	ID          types.Int64  `tfsdk:"id"`
+	ForceSave   types.Bool   `tfsdk:"force_save"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerProxySocks4) toIndexerProxy() *IndexerProxy {
@@ -98,6 +103,14 @@ func (r *IndexerProxySocks4Resource) Schema(_ context.Context, _ resource.Schema
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "Port.",
@@ -121,11 +134,17 @@ func (r *IndexerProxySocks4Resource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *IndexerProxySocks4Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *IndexerProxySocks4Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxySocks4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxySocks4
@@ -136,12 +155,23 @@ func (r *IndexerProxySocks4Resource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerProxySocks4
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.CreateIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Create, indexerProxySocks4ResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.CreateIndexerProxy(helpers.ContextWithForceSave(ctx)).IndexerProxyResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxySocks4ResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerProxySocks4ResourceName, err)
 
 		return
 	}
@@ -150,6 +180,13 @@ func (r *IndexerProxySocks4Resource) Create(ctx context.Context, req resource.Cr
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxySocks4ResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxySocks4Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -163,9 +200,9 @@ func (r *IndexerProxySocks4Resource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get IndexerProxySocks4 current value
-	response, _, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyApi.GetIndexerProxyById(ctx, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxySocks4ResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxySocks4ResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -186,12 +223,23 @@ func (r *IndexerProxySocks4Resource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &proxy.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerProxySocks4
 	request := proxy.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, proxy.ForceSave.ValueBool(), helpers.Update, indexerProxySocks4ResourceName, err) {
+		response, _, err = r.client.IndexerProxyApi.UpdateIndexerProxy(ctx, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxySocks4ResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerProxySocks4ResourceName, err)
 
 		return
 	}
@@ -200,6 +248,13 @@ func (r *IndexerProxySocks4Resource) Update(ctx context.Context, req resource.Up
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &proxy)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerProxySocks4ResourceName, proxy.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerProxyApi.TestIndexerProxy(ctx).IndexerProxyResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerProxySocks4Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
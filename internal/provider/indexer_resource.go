@@ -2,29 +2,53 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const indexerResourceName = "indexer"
+const (
+	indexerResourceName = "indexer"
+	// downloadClientId is not part of the SDK model yet, so it is added to the indexer API request body
+	// and decoded from the response body. To be removed once prowlarr-go IndexerResource includes it.
+	indexerDownloadClientField = "downloadClientId"
+	indexerAPIPath             = "/api/v1/indexer"
+	// indexerDownloadClientMinVersion is the first Prowlarr version supporting a download client per indexer.
+	indexerDownloadClientMinVersion = "1.11.0"
+	indexerMinimumSeedersField      = "torrentBaseSettings.appMinimumSeeders"
+	indexerSeedRatioField           = "torrentBaseSettings.seedRatio"
+	indexerSeedTimeField            = "torrentBaseSettings.seedTime"
+	indexerPackSeedTimeField        = "torrentBaseSettings.packSeedTime"
+	indexerPreferMagnetURLField     = "torrentBaseSettings.preferMagnetUrl"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &IndexerResource{}
 	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerResource{}
 )
 
 func NewIndexerResource() resource.Resource {
@@ -33,13 +57,16 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *prowlarr.APIClient
+	client       *prowlarr.APIClient
+	defaultTags  *helpers.DefaultTags
+	testOnApply  bool
+	providerData *helpers.ProviderData
 }
 
 // Indexer describes the indexer data model.
 type Indexer struct {
-	Tags types.Set `tfsdk:"tags"`
-	// IndexerURLs    types.Set    `tfsdk:"indexer_urls"`
+	Tags           types.Set    `tfsdk:"tags"`
+	IndexerURLs    types.Set    `tfsdk:"indexer_urls"`
 	Fields         types.Set    `tfsdk:"fields"`
 	ConfigContract types.String `tfsdk:"config_contract"`
 	Implementation types.String `tfsdk:"implementation"`
@@ -51,6 +78,88 @@ type Indexer struct {
 	Priority       types.Int64  `tfsdk:"priority"`
 	ID             types.Int64  `tfsdk:"id"`
 	Enable         types.Bool   `tfsdk:"enable"`
+	// AllFields contains all the fields returned by the API, exposed only by the resource.
+	AllFields types.Set `tfsdk:"-"`
+}
+
+// IndexerGeneric describes the generic indexer resource data model.
+type IndexerGeneric struct {
+	Tags              types.Set    `tfsdk:"tags"`
+	IndexerURLs       types.Set    `tfsdk:"indexer_urls"`
+	Fields            types.Set    `tfsdk:"fields"`
+	AllFields         types.Set    `tfsdk:"all_fields"`
+	Settings          types.Map    `tfsdk:"settings"`
+	SensitiveSettings types.Map    `tfsdk:"sensitive_settings"`
+	TorrentSettings   types.Object `tfsdk:"torrent_settings"`
+	ConfigContract    types.String `tfsdk:"config_contract"`
+	Implementation    types.String `tfsdk:"implementation"`
+	Name              types.String `tfsdk:"name"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Language          types.String `tfsdk:"language"`
+	Privacy           types.String `tfsdk:"privacy"`
+	AppProfileID      types.Int64  `tfsdk:"app_profile_id"`
+	Priority          types.Int64  `tfsdk:"priority"`
+	DownloadClientID  types.Int64  `tfsdk:"download_client_id"`
+	ID                types.Int64  `tfsdk:"id"`
+	Enable            types.Bool   `tfsdk:"enable"`
+	ForceSave         types.Bool   `tfsdk:"force_save"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
+}
+
+// IndexerTorrentSettings is part of IndexerGeneric.
+type IndexerTorrentSettings struct {
+	SeedRatio       types.Float64 `tfsdk:"seed_ratio"`
+	MinimumSeeders  types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime        types.Int64   `tfsdk:"seed_time"`
+	PackSeedTime    types.Int64   `tfsdk:"pack_seed_time"`
+	PreferMagnetURL types.Bool    `tfsdk:"prefer_magnet_url"`
+}
+
+func (s IndexerTorrentSettings) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"seed_ratio":        types.Float64Type,
+			"minimum_seeders":   types.Int64Type,
+			"seed_time":         types.Int64Type,
+			"pack_seed_time":    types.Int64Type,
+			"prefer_magnet_url": types.BoolType,
+		})
+}
+
+func (i IndexerGeneric) toIndexer() *Indexer {
+	return &Indexer{
+		Tags:           i.Tags,
+		IndexerURLs:    i.IndexerURLs,
+		Fields:         i.Fields,
+		AllFields:      i.AllFields,
+		ConfigContract: i.ConfigContract,
+		Implementation: i.Implementation,
+		Name:           i.Name,
+		Protocol:       i.Protocol,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+	}
+}
+
+func (i *IndexerGeneric) fromIndexer(indexer *Indexer) {
+	i.Tags = indexer.Tags
+	i.IndexerURLs = indexer.IndexerURLs
+	i.Fields = indexer.Fields
+	i.AllFields = indexer.AllFields
+	i.ConfigContract = indexer.ConfigContract
+	i.Implementation = indexer.Implementation
+	i.Name = indexer.Name
+	i.Protocol = indexer.Protocol
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
 }
 
 // Field is part of Indexer.
@@ -119,6 +228,69 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Privacy.",
 				Computed:            true,
 			},
+			"indexer_urls": schema.SetAttribute{
+				MarkdownDescription: "Available indexer URLs, to be used as `baseUrl` field.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"download_client_id": schema.Int64Attribute{
+				MarkdownDescription: "Download client ID used to grab releases from this indexer. `0` means any download client of the matching protocol. Requires Prowlarr `1.11.0` or newer.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"torrent_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Torrent seeding settings. Only valid for `torrent` protocol. The related fields must not be configured in `fields` or `settings`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"minimum_seeders": schema.Int64Attribute{
+						MarkdownDescription: "Minimum seeders required by the applications to grab a release.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"seed_ratio": schema.Float64Attribute{
+						MarkdownDescription: "Seed ratio before stopping the torrent.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Float64{
+							float64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"seed_time": schema.Int64Attribute{
+						MarkdownDescription: "Seed time in minutes before stopping the torrent.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"pack_seed_time": schema.Int64Attribute{
+						MarkdownDescription: "Season pack seed time in minutes before stopping the torrent.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"prefer_magnet_url": schema.BoolAttribute{
+						MarkdownDescription: "Prefer magnet URL over torrent file when grabbing.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -126,12 +298,42 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			"fields": schema.SetNestedAttribute{
-				Required:            true,
-				MarkdownDescription: "Set of configuration fields.",
+				Optional:            true,
+				MarkdownDescription: "Set of configuration fields. Only the configured fields are tracked. It conflicts with `settings` and `sensitive_settings`, one of them must be set.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getFieldSchema().Attributes,
 				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("settings"), path.MatchRoot("sensitive_settings")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("settings"), path.MatchRoot("sensitive_settings")),
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Map of configuration fields by name (e.g. `baseUrl`), converted to the field type defined in the indexer schema. Lists are comma separated (e.g. `\"1,5\"`). It conflicts with `fields`, the same name must not be set in `sensitive_settings`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"sensitive_settings": schema.MapAttribute{
+				MarkdownDescription: "Map of sensitive configuration fields by name (e.g. `apiKey`), same as `settings`. It conflicts with `fields`.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"all_fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of all the configuration fields returned by Prowlarr, including the ones not configured.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getAllFieldSchema().Attributes,
+				},
 			},
 		},
 	}
@@ -175,15 +377,95 @@ func (r IndexerResource) getFieldSchema() schema.Schema {
 	}
 }
 
+func (r IndexerResource) getAllFieldSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Field name.",
+				Computed:            true,
+			},
+			"text_value": schema.StringAttribute{
+				MarkdownDescription: "Text value.",
+				Computed:            true,
+			},
+			"sensitive_value": schema.StringAttribute{
+				MarkdownDescription: "Sensitive string value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"number_value": schema.NumberAttribute{
+				MarkdownDescription: "Number value.",
+				Computed:            true,
+			},
+			"bool_value": schema.BoolAttribute{
+				MarkdownDescription: "Bool value.",
+				Computed:            true,
+			},
+			"set_value": schema.SetAttribute{
+				MarkdownDescription: "Set value.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
 func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+		r.providerData = data
+	}
+}
+
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+
+	// Nothing to validate on destroy or without a configured provider.
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var indexer *IndexerGeneric
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !indexer.DownloadClientID.IsNull() {
+		r.providerData.CheckMinVersion(&resp.Diagnostics, path.Root("download_client_id"), indexerDownloadClientMinVersion)
+	}
+
+	// torrent settings are returned only for torrent indexers, the prior ones must not be kept.
+	if indexer.TorrentSettings.IsNull() && !indexer.Protocol.IsUnknown() && indexer.Protocol.ValueString() != string(prowlarr.DOWNLOADPROTOCOL_TORRENT) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("torrent_settings"), types.ObjectNull(IndexerTorrentSettings{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes()))...)
+	}
+
+	indexer.validateTorrentSettings(ctx, &resp.Diagnostics)
+	validateDuplicateSettings(indexer.Settings, indexer.SensitiveSettings, &resp.Diagnostics)
+
+	schema := indexer.validate(ctx, r.providerData, &resp.Diagnostics)
+
+	// the configured base URL is already checked.
+	if url, _ := indexer.textField(ctx, helpers.BaseURLField, &resp.Diagnostics); schema == nil || url != "" || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *IndexerGeneric
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if !resp.Diagnostics.HasError() {
+		state.checkLegacyURL(ctx, schema, &resp.Diagnostics)
 	}
 }
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *IndexerGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -191,12 +473,30 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Indexer
-	request := indexer.read(ctx, &resp.Diagnostics)
+	schemaFields := indexer.settingsSchemaFields(ctx, r.providerData, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, schemaFields, &resp.Diagnostics)
+	requestCtx := helpers.ContextWithBodyFields(ctx, indexerAPIPath, indexer.bodyFields())
+
+	response, httpResp, err := r.client.IndexerApi.CreateIndexer(requestCtx).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Create, indexerResourceName, err) {
+		response, httpResp, err = r.client.IndexerApi.CreateIndexer(helpers.ContextWithForceSave(requestCtx)).IndexerResource(*request).Execute()
+	}
 
-	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerResourceName, err)
 
 		return
 	}
@@ -204,12 +504,20 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.writeDownloadClientID(httpResp, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *IndexerGeneric
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -218,9 +526,9 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+		helpers.HandleReadError(ctx, indexerResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -228,12 +536,13 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.writeDownloadClientID(httpResp, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, indexer)...)
 }
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var indexer *Indexer
+	var indexer *IndexerGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -241,12 +550,33 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the fields not managed by Terraform
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("all_fields"), &indexer.AllFields)...)
+
 	// Update Indexer
-	request := indexer.read(ctx, &resp.Diagnostics)
+	schemaFields := indexer.settingsSchemaFields(ctx, r.providerData, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, schemaFields, &resp.Diagnostics)
+	requestCtx := helpers.ContextWithBodyFields(ctx, indexerAPIPath, indexer.bodyFields())
+
+	response, httpResp, err := r.client.IndexerApi.UpdateIndexer(requestCtx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Update, indexerResourceName, err) {
+		response, httpResp, err = r.client.IndexerApi.UpdateIndexer(requestCtx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).ForceSave(true).Execute()
+	}
 
-	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerResourceName, err)
 
 		return
 	}
@@ -254,7 +584,15 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.writeDownloadClientID(httpResp, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,11 +621,198 @@ func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportSt
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
+func (i *IndexerGeneric) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+
+	if !i.Settings.IsNull() || !i.SensitiveSettings.IsNull() {
+		i.Fields = types.SetNull(IndexerResource{}.getFieldSchema().Type())
+		i.Settings = helpers.WriteSettings(ctx, indexer.GetFields(), i.Settings, diags)
+		i.SensitiveSettings = helpers.WriteSettings(ctx, indexer.GetFields(), i.SensitiveSettings, diags)
+	}
+
+	i.TorrentSettings = types.ObjectNull(IndexerTorrentSettings{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+
+	if indexer.GetProtocol() == prowlarr.DOWNLOADPROTOCOL_TORRENT {
+		var (
+			torrent  IndexerTorrentSettings
+			tempDiag diag.Diagnostics
+		)
+
+		torrent.write(indexer.GetFields())
+		i.TorrentSettings, tempDiag = types.ObjectValueFrom(ctx, torrent.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), torrent)
+		diags.Append(tempDiag...)
+	}
+}
+
+// writeDownloadClientID reads the download client ID from the response body, since it is not part of the SDK model.
+func (i *IndexerGeneric) writeDownloadClientID(httpResp *http.Response, diags *diag.Diagnostics) {
+	var id int64
+
+	found, err := helpers.DecodeBodyField(httpResp, indexerDownloadClientField, &id)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+
+		return
+	}
+
+	i.DownloadClientID = types.Int64Null()
+	if found {
+		i.DownloadClientID = types.Int64Value(id)
+	}
+}
+
+// bodyFields returns the request fields which are not part of the SDK model.
+func (i *IndexerGeneric) bodyFields() map[string]any {
+	if i.DownloadClientID.IsNull() || i.DownloadClientID.IsUnknown() {
+		return nil
+	}
+
+	return map[string]any{indexerDownloadClientField: i.DownloadClientID.ValueInt64()}
+}
+
+func (i *IndexerGeneric) read(ctx context.Context, schemaFields []*prowlarr.Field, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	indexer := i.toIndexer().read(ctx, diags)
+	indexer.Fields = helpers.MergeFields(indexer.Fields, helpers.ReadSettings(ctx, i.Settings, schemaFields, diags))
+	indexer.Fields = helpers.MergeFields(indexer.Fields, helpers.ReadSettings(ctx, i.SensitiveSettings, schemaFields, diags))
+
+	if !i.TorrentSettings.IsNull() && !i.TorrentSettings.IsUnknown() {
+		var torrent IndexerTorrentSettings
+
+		diags.Append(i.TorrentSettings.As(ctx, &torrent, basetypes.ObjectAsOptions{})...)
+		indexer.Fields = helpers.MergeFields(indexer.Fields, torrent.read())
+	}
+
+	return indexer
+}
+
+// validateDuplicateSettings checks that no setting is configured both in settings and in sensitive settings.
+func validateDuplicateSettings(settings, sensitiveSettings types.Map, diags *diag.Diagnostics) {
+	for _, name := range helpers.DuplicateSettings(settings, sensitiveSettings) {
+		diags.AddAttributeError(
+			path.Root("sensitive_settings"),
+			"Duplicate Indexer Setting",
+			fmt.Sprintf("Setting '%s' must be configured either in settings or in sensitive_settings, not in both", name),
+		)
+	}
+}
+
+// validateTorrentSettings checks that torrent settings are set only for torrent indexers and not configured twice.
+func (i *IndexerGeneric) validateTorrentSettings(ctx context.Context, diags *diag.Diagnostics) {
+	if i.TorrentSettings.IsNull() || i.TorrentSettings.IsUnknown() {
+		return
+	}
+
+	if !i.Protocol.IsUnknown() && i.Protocol.ValueString() != string(prowlarr.DOWNLOADPROTOCOL_TORRENT) {
+		diags.AddAttributeError(
+			path.Root("torrent_settings"),
+			"Invalid Torrent Settings",
+			fmt.Sprintf("Torrent settings can only be set for torrent indexers, got protocol '%s'", i.Protocol.ValueString()),
+		)
+	}
+
+	names := make([]string, 0, len(i.Settings.Elements())+len(i.SensitiveSettings.Elements()))
+
+	for _, settings := range []types.Map{i.Settings, i.SensitiveSettings} {
+		for name := range settings.Elements() {
+			names = append(names, name)
+		}
+	}
+
+	if !i.Fields.IsUnknown() {
+		fields := make([]Field, len(i.Fields.Elements()))
+		diags.Append(i.Fields.ElementsAs(ctx, &fields, true)...)
+
+		for _, f := range fields {
+			names = append(names, f.Name.ValueString())
+		}
+	}
+
+	for _, name := range names {
+		if slices.Contains(IndexerTorrentSettings{}.fieldNames(), name) {
+			diags.AddAttributeError(
+				path.Root("torrent_settings"),
+				"Conflicting Indexer Field",
+				fmt.Sprintf("Field '%s' is managed by torrent_settings and must not be configured in fields or settings", name),
+			)
+		}
+	}
+}
+
+func (s IndexerTorrentSettings) fieldNames() []string {
+	return []string{indexerMinimumSeedersField, indexerSeedRatioField, indexerSeedTimeField, indexerPackSeedTimeField, indexerPreferMagnetURLField}
+}
+
+func (s *IndexerTorrentSettings) write(fields []*prowlarr.Field) {
+	s.MinimumSeeders = types.Int64Null()
+	s.SeedRatio = types.Float64Null()
+	s.SeedTime = types.Int64Null()
+	s.PackSeedTime = types.Int64Null()
+	s.PreferMagnetURL = types.BoolNull()
+
+	for _, f := range fields {
+		switch v := f.GetValue().(type) {
+		case float64:
+			switch f.GetName() {
+			case indexerMinimumSeedersField:
+				s.MinimumSeeders = types.Int64Value(int64(v))
+			case indexerSeedRatioField:
+				s.SeedRatio = types.Float64Value(v)
+			case indexerSeedTimeField:
+				s.SeedTime = types.Int64Value(int64(v))
+			case indexerPackSeedTimeField:
+				s.PackSeedTime = types.Int64Value(int64(v))
+			}
+		case bool:
+			if f.GetName() == indexerPreferMagnetURLField {
+				s.PreferMagnetURL = types.BoolValue(v)
+			}
+		}
+	}
+}
+
+func (s *IndexerTorrentSettings) read() []*prowlarr.Field {
+	values := map[string]attr.Value{
+		indexerMinimumSeedersField:  s.MinimumSeeders,
+		indexerSeedRatioField:       s.SeedRatio,
+		indexerSeedTimeField:        s.SeedTime,
+		indexerPackSeedTimeField:    s.PackSeedTime,
+		indexerPreferMagnetURLField: s.PreferMagnetURL,
+	}
+	fields := make([]*prowlarr.Field, 0, len(values))
+
+	for _, name := range s.fieldNames() {
+		value := values[name]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		field := prowlarr.NewField()
+		field.SetName(name)
+
+		switch v := value.(type) {
+		case types.Int64:
+			field.SetValue(v.ValueInt64())
+		case types.Float64:
+			field.SetValue(v.ValueFloat64())
+		case types.Bool:
+			field.SetValue(v.ValueBool())
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
 func (i *Indexer) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
 	i.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, indexer.Tags)
 	diags.Append(localDiag...)
+	i.IndexerURLs, localDiag = types.SetValueFrom(ctx, types.StringType, indexer.GetIndexerUrls())
+	diags.Append(localDiag...)
 
 	i.Enable = types.BoolValue(indexer.GetEnable())
 	i.Priority = types.Int64Value(int64(indexer.GetPriority()))
@@ -300,19 +825,306 @@ func (i *Indexer) write(ctx context.Context, indexer *prowlarr.IndexerResource,
 	i.Language = types.StringValue(indexer.GetLanguage())
 	i.Privacy = types.StringValue(string(indexer.GetPrivacy()))
 
-	var fields []Field
+	// prior values are needed to keep secrets, since Prowlarr returns them masked,
+	// and to track only the configured fields.
+	priorFields := make(map[string]Field)
+	configured := !i.Fields.IsNull() && !i.Fields.IsUnknown()
+
+	if configured {
+		priorList := make([]Field, len(i.Fields.Elements()))
+		diags.Append(i.Fields.ElementsAs(ctx, &priorList, true)...)
+
+		for _, f := range priorList {
+			priorFields[f.Name.ValueString()] = f
+		}
+	}
+
+	var fields, allFields []Field
 
 	for _, f := range indexer.GetFields() {
 		if _, ok := f.GetValueOk(); ok {
 			var field Field
 
 			field.write(ctx, f, diags)
-			fields = append(fields, field)
+
+			prior, ok := priorFields[field.Name.ValueString()]
+			if ok {
+				field.unmask(prior)
+			}
+
+			allFields = append(allFields, field)
+
+			if ok || !configured {
+				fields = append(fields, field)
+			}
 		}
 	}
 
 	i.Fields, localDiag = types.SetValueFrom(ctx, IndexerResource{}.getFieldSchema().Type(), fields)
 	diags.Append(localDiag...)
+	i.AllFields, localDiag = types.SetValueFrom(ctx, IndexerResource{}.getFieldSchema().Type(), allFields)
+	diags.Append(localDiag...)
+}
+
+// definition returns the Cardigann definition from fields or settings, false if unknown.
+func (i *IndexerGeneric) definition(ctx context.Context, diags *diag.Diagnostics) (string, bool) {
+	return i.textField(ctx, helpers.DefinitionFileField, diags)
+}
+
+// textField returns the text value of a field from fields or settings, false if unknown.
+func (i *IndexerGeneric) textField(ctx context.Context, name string, diags *diag.Diagnostics) (string, bool) {
+	if i.Fields.IsUnknown() || i.Settings.IsUnknown() {
+		return "", false
+	}
+
+	if value, ok := i.Settings.Elements()[name].(types.String); ok {
+		return value.ValueString(), !value.IsUnknown()
+	}
+
+	fields := make([]Field, len(i.Fields.Elements()))
+	diags.Append(i.Fields.ElementsAs(ctx, &fields, true)...)
+
+	for _, f := range fields {
+		if f.Name.ValueString() == name {
+			return f.TextValue.ValueString(), !f.TextValue.IsUnknown()
+		}
+	}
+
+	return "", true
+}
+
+// findSchema returns the indexer schema matching implementation, config contract and definition.
+func (i *IndexerGeneric) findSchema(schemas []*prowlarr.IndexerResource, definition string, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	schema := helpers.FindIndexerSchema(schemas, i.Implementation.ValueString(), i.ConfigContract.ValueString(), definition)
+	if schema == nil {
+		diags.AddAttributeError(
+			path.Root("implementation"),
+			"Unknown Indexer Schema",
+			fmt.Sprintf("No indexer schema found for implementation '%s', config contract '%s' and definition '%s'", i.Implementation.ValueString(), i.ConfigContract.ValueString(), definition),
+		)
+	}
+
+	return schema
+}
+
+// settingsSchemaFields returns the schema fields needed to convert settings, nil if settings are not used.
+func (i *IndexerGeneric) settingsSchemaFields(ctx context.Context, data *helpers.ProviderData, diags *diag.Diagnostics) []*prowlarr.Field {
+	if i.Settings.IsNull() && i.SensitiveSettings.IsNull() {
+		return nil
+	}
+
+	schemas, err := data.IndexerSchemas(ctx)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return nil
+	}
+
+	definition, _ := i.definition(ctx, diags)
+
+	if schema := i.findSchema(schemas, definition, diags); schema != nil {
+		return schema.GetFields()
+	}
+
+	return nil
+}
+
+// validate checks the configured fields and settings against the matching indexer schema.
+// The schema is returned if found.
+func (i *IndexerGeneric) validate(ctx context.Context, data *helpers.ProviderData, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	if i.Implementation.IsUnknown() || i.ConfigContract.IsUnknown() {
+		return nil
+	}
+
+	definition, ok := i.definition(ctx, diags)
+	if !ok {
+		return nil
+	}
+
+	// validation is skipped if schemas are not reachable, errors will be reported on apply.
+	schemas, err := data.IndexerSchemas(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to validate indexer fields", helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return nil
+	}
+
+	schema := i.findSchema(schemas, definition, diags)
+	if schema == nil {
+		return nil
+	}
+
+	for _, settings := range []struct {
+		value types.Map
+		name  string
+	}{{i.Settings, "settings"}, {i.SensitiveSettings, "sensitive_settings"}} {
+		for _, message := range helpers.ValidateSettings(settings.value, schema.GetFields()) {
+			diags.AddAttributeError(path.Root(settings.name), "Invalid Indexer Setting", message)
+		}
+	}
+
+	if !i.Fields.IsUnknown() {
+		i.toIndexer().validateFields(ctx, schema, diags)
+	}
+
+	i.validateBaseURL(ctx, schema, diags)
+
+	return schema
+}
+
+// validateBaseURL warns if the configured base URL is not one of the indexer URLs.
+func (i *IndexerGeneric) validateBaseURL(ctx context.Context, schema *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	url, ok := i.textField(ctx, helpers.BaseURLField, diags)
+	indexerURLs := stringList(schema.GetIndexerUrls())
+
+	if !ok || url == "" || len(indexerURLs) == 0 || helpers.ContainsIndexerURL(indexerURLs, url) {
+		return
+	}
+
+	attribute := path.Root("fields")
+	if !i.Settings.IsNull() {
+		attribute = path.Root("settings")
+	}
+
+	if helpers.ContainsIndexerURL(stringList(schema.GetLegacyUrls()), url) {
+		diags.AddAttributeWarning(
+			attribute,
+			"Legacy Indexer URL",
+			fmt.Sprintf("Base URL '%s' is a legacy URL for indexer '%s', use one of: %s", url, schema.GetName(), strings.Join(indexerURLs, ", ")),
+		)
+
+		return
+	}
+
+	diags.AddAttributeWarning(
+		attribute,
+		"Unknown Indexer URL",
+		fmt.Sprintf("Base URL '%s' is not one of the URLs of indexer '%s': %s", url, schema.GetName(), strings.Join(indexerURLs, ", ")),
+	)
+}
+
+// checkLegacyURL warns if the indexer in state uses a URL listed only in the legacy ones.
+func (i *IndexerGeneric) checkLegacyURL(ctx context.Context, schema *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	fields := make([]Field, len(i.AllFields.Elements()))
+	diags.Append(i.AllFields.ElementsAs(ctx, &fields, true)...)
+
+	indexerURLs := stringList(schema.GetIndexerUrls())
+
+	for _, f := range fields {
+		url := f.TextValue.ValueString()
+		if f.Name.ValueString() != helpers.BaseURLField || helpers.ContainsIndexerURL(indexerURLs, url) {
+			continue
+		}
+
+		if helpers.ContainsIndexerURL(stringList(schema.GetLegacyUrls()), url) {
+			diags.AddWarning(
+				"Legacy Indexer URL",
+				fmt.Sprintf("Indexer '%s' is using the legacy URL '%s', set the baseUrl field to one of: %s", i.Name.ValueString(), url, strings.Join(indexerURLs, ", ")),
+			)
+		}
+	}
+}
+
+// stringList returns the values of a list of string pointers.
+func stringList(values []*string) []string {
+	list := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if v != nil {
+			list = append(list, *v)
+		}
+	}
+
+	return list
+}
+
+// validateFields checks the configured fields against the matching indexer schema.
+func (i *Indexer) validateFields(ctx context.Context, schema *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	fields := make([]Field, len(i.Fields.Elements()))
+	diags.Append(i.Fields.ElementsAs(ctx, &fields, true)...)
+
+	fieldTypes := make(map[string]string, len(schema.GetFields()))
+	names := make([]string, 0, len(schema.GetFields()))
+
+	for _, f := range schema.GetFields() {
+		fieldTypes[f.GetName()] = f.GetType()
+		names = append(names, f.GetName())
+	}
+
+	for _, f := range fields {
+		if f.Name.IsUnknown() {
+			continue
+		}
+
+		name := f.Name.ValueString()
+		values := f.valueAttributes()
+
+		if len(values) != 1 {
+			diags.AddAttributeError(
+				path.Root("fields"),
+				"Invalid Indexer Field",
+				fmt.Sprintf("Field '%s' must have exactly one value, got %d", name, len(values)),
+			)
+		}
+
+		fieldType, ok := fieldTypes[name]
+		if !ok {
+			detail := fmt.Sprintf("Field '%s' is not defined for indexer '%s'.", name, schema.GetName())
+			if match := helpers.ClosestMatch(name, names); match != "" {
+				detail += fmt.Sprintf(" Did you mean '%s'?", match)
+			}
+
+			diags.AddAttributeError(path.Root("fields"), "Unknown Indexer Field", detail)
+
+			continue
+		}
+
+		allowed := helpers.FieldValueAttributes(fieldType)
+		if len(values) == 1 && slices.Contains(helpers.DeprecatedFieldValueAttributes(fieldType), values[0]) {
+			diags.AddAttributeWarning(
+				path.Root("fields"),
+				"Deprecated Indexer Field Type",
+				fmt.Sprintf("Field '%s' of type '%s' should be set using %s, %s is deprecated", name, fieldType, strings.Join(allowed, " or "), values[0]),
+			)
+
+			continue
+		}
+
+		if len(values) == 1 && allowed != nil && !slices.Contains(allowed, values[0]) {
+			diags.AddAttributeError(
+				path.Root("fields"),
+				"Invalid Indexer Field Type",
+				fmt.Sprintf("Field '%s' of type '%s' must be set using %s, got %s", name, fieldType, strings.Join(allowed, " or "), values[0]),
+			)
+		}
+	}
+}
+
+// valueAttributes returns the names of the value attributes which are set, including unknown ones.
+func (f *Field) valueAttributes() []string {
+	values := make([]string, 0, 1)
+
+	if !f.TextValue.IsNull() {
+		values = append(values, "text_value")
+	}
+
+	if !f.SensitiveValue.IsNull() {
+		values = append(values, "sensitive_value")
+	}
+
+	if !f.NumberValue.IsNull() {
+		values = append(values, "number_value")
+	}
+
+	if !f.BoolValue.IsNull() {
+		values = append(values, "bool_value")
+	}
+
+	if !f.SetValue.IsNull() {
+		values = append(values, "set_value")
+	}
+
+	return values
 }
 
 func (f *Field) write(ctx context.Context, field *prowlarr.Field, diags *diag.Diagnostics) {
@@ -354,15 +1166,22 @@ func (f *Field) write(ctx context.Context, field *prowlarr.Field, diags *diag.Di
 	}
 }
 
-func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
-	fieldList := make([]Field, len(i.Fields.Elements()))
-	diags.Append(i.Fields.ElementsAs(ctx, &fieldList, true)...)
-	fields := make([]*prowlarr.Field, len(fieldList))
-
-	for n, f := range fieldList {
-		fields[n] = f.read(ctx, diags)
+// unmask keeps the prior string values when the API returns them masked.
+// A sensitive value configured with the deprecated text_value is kept as text.
+func (f *Field) unmask(prior Field) {
+	if !prior.TextValue.IsNull() && !f.SensitiveValue.IsNull() {
+		f.TextValue, f.SensitiveValue = f.SensitiveValue, types.StringNull()
 	}
 
+	f.SensitiveValue = helpers.UnmaskString(f.SensitiveValue, prior.SensitiveValue)
+	f.TextValue = helpers.UnmaskString(f.TextValue, prior.TextValue)
+}
+
+func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	// all the known fields are sent back, since Prowlarr resets the missing ones to their default.
+	fields := readFieldSet(ctx, i.AllFields, diags)
+	fields = helpers.MergeFields(fields, readFieldSet(ctx, i.Fields, diags))
+
 	indexer := prowlarr.NewIndexerResource()
 	indexer.SetEnable(i.Enable.ValueBool())
 	indexer.SetPriority(int32(i.Priority.ValueInt64()))
@@ -378,6 +1197,23 @@ func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.I
 	return indexer
 }
 
+// readFieldSet converts a set of fields, an unknown or null set returns no field.
+func readFieldSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []*prowlarr.Field {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	fieldList := make([]Field, len(set.Elements()))
+	diags.Append(set.ElementsAs(ctx, &fieldList, true)...)
+	fields := make([]*prowlarr.Field, len(fieldList))
+
+	for n, f := range fieldList {
+		fields[n] = f.read(ctx, diags)
+	}
+
+	return fields
+}
+
 func (f *Field) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.Field {
 	field := prowlarr.NewField()
 	field.SetName(f.Name.ValueString())
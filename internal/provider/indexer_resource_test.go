@@ -20,12 +20,20 @@ func TestAccIndexerResource(t *testing.T) {
 				Config:      testAccIndexerResourceConfig("resourceTest", "https://0magnet.co/") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Torrent settings on usenet indexer
+			{
+				Config:      testAccIndexerResourceTorrentError,
+				ExpectError: regexp.MustCompile("Invalid Torrent Settings"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccIndexerResourceConfig("resourceTest", "https://0magnet.co/"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// resource.TestCheckResourceAttr("prowlarr_indexer.test", "enable_automatic_search", "false"),
 					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer.test", "fields.*", map[string]string{"name": "baseSettings.queryLimit"}),
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "torrent_settings.seed_ratio", "0.5"),
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "download_client_id", "0"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer.test", "indexer_urls.#"),
 					resource.TestCheckResourceAttrSet("prowlarr_indexer.test", "id"),
 				),
 			},
@@ -43,15 +51,85 @@ func TestAccIndexerResource(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:      "prowlarr_indexer.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "prowlarr_indexer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexerResourcePartialFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the query limit
+			{
+				Config: testAccIndexerResourcePartialConfig("partialTest", `
+				{
+					name = "baseSettings.queryLimit"
+					number_value = 7
+				},`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "fields.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer.test", "fields.*", map[string]string{"name": "baseSettings.queryLimit", "number_value": "7"}),
+					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer.test", "all_fields.*", map[string]string{"name": "baseSettings.queryLimit", "number_value": "7"}),
+				),
+			},
+			// Update without the query limit, which is kept
+			{
+				Config: testAccIndexerResourcePartialConfig("partialTestUpdated", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "name", "partialTestUpdated"),
+					resource.TestCheckResourceAttr("prowlarr_indexer.test", "fields.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("prowlarr_indexer.test", "all_fields.*", map[string]string{"name": "baseSettings.queryLimit", "number_value": "7"}),
+				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func testAccIndexerResourcePartialConfig(name, fields string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer" "test" {
+		enable = false
+		name = "%s"
+		implementation = "Cardigann"
+		config_contract = "CardigannSettings"
+		protocol = "torrent"
+		tags = []
+
+		fields = [%s
+			{
+				name = "definitionFile"
+				text_value = "0magnet"
+			},
+			{
+				name = "baseUrl"
+				text_value = "https://0magnet.co/"
+			}
+		]
+	}`, name, fields)
+}
+
+const testAccIndexerResourceTorrentError = `
+resource "prowlarr_indexer" "test" {
+	name = "error"
+	implementation = "Newznab"
+	config_contract = "NewznabSettings"
+	protocol = "usenet"
+	torrent_settings = {
+		seed_ratio = 1
+	}
+}
+`
+
 func testAccIndexerResourceConfig(name, url string) string {
 	return fmt.Sprintf(`
 	resource "prowlarr_indexer" "test" {
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,6 +33,7 @@ type IndexerSchema struct {
 	IndexerURLs    types.Set    `tfsdk:"indexer_urls"`
 	LegacyURLs     types.Set    `tfsdk:"legacy_urls"`
 	Fields         types.Set    `tfsdk:"fields"`
+	Capabilities   types.Object `tfsdk:"capabilities"`
 	ConfigContract types.String `tfsdk:"config_contract"`
 	Implementation types.String `tfsdk:"implementation"`
 	Name           types.String `tfsdk:"name"`
@@ -44,9 +47,109 @@ type IndexerSchema struct {
 
 // SchemaField is part of IndexerSchema.
 type SchemaField struct {
+	SelectOptions types.List   `tfsdk:"select_options"`
+	Name          types.String `tfsdk:"name"`
+	Label         types.String `tfsdk:"label"`
+	Description   types.String `tfsdk:"description"`
+	HelpText      types.String `tfsdk:"help_text"`
+	Type          types.String `tfsdk:"type"`
+	Default       types.String `tfsdk:"default"`
+	Privacy       types.String `tfsdk:"privacy"`
+	Unit          types.String `tfsdk:"unit"`
+	Order         types.Int64  `tfsdk:"order"`
+	Advanced      types.Bool   `tfsdk:"advanced"`
+}
+
+// SchemaSelectOption is part of SchemaField.
+type SchemaSelectOption struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+func (o SchemaSelectOption) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"value": types.Int64Type,
+		})
+}
+
+// SchemaCapabilities is part of IndexerSchema.
+type SchemaCapabilities struct {
+	Categories        types.Set   `tfsdk:"categories"`
+	SearchParams      types.Set   `tfsdk:"search_params"`
+	TVSearchParams    types.Set   `tfsdk:"tv_search_params"`
+	MovieSearchParams types.Set   `tfsdk:"movie_search_params"`
+	MusicSearchParams types.Set   `tfsdk:"music_search_params"`
+	BookSearchParams  types.Set   `tfsdk:"book_search_params"`
+	LimitsMax         types.Int64 `tfsdk:"limits_max"`
+	LimitsDefault     types.Int64 `tfsdk:"limits_default"`
+	SupportsRawSearch types.Bool  `tfsdk:"supports_raw_search"`
+}
+
+// SchemaCategory is part of SchemaCapabilities.
+type SchemaCategory struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
-	Type        types.String `tfsdk:"type"`
+	ID          types.Int64  `tfsdk:"id"`
+	ParentID    types.Int64  `tfsdk:"parent_id"`
+}
+
+func (c SchemaCategory) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":        types.StringType,
+			"description": types.StringType,
+			"id":          types.Int64Type,
+			"parent_id":   types.Int64Type,
+		})
+}
+
+// schemaFieldPrivacy contains the field privacy of a schema, which is not part of the SDK model yet.
+// It can be decoded from any schema list response.
+type schemaFieldPrivacy struct {
+	Fields []struct {
+		Name    string `json:"name"`
+		Privacy string `json:"privacy"`
+	} `json:"fields"`
+}
+
+// decodeSchemaFieldPrivacy returns the field privacy of the schema at the given position of the raw schema list response.
+func decodeSchemaFieldPrivacy(httpResp *http.Response, position int, name string, diags *diag.Diagnostics) map[string]string {
+	var schemas []schemaFieldPrivacy
+	if err := helpers.DecodeBody(httpResp, &schemas); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, name, err))
+
+		return nil
+	}
+
+	privacy := make(map[string]string)
+
+	if position < len(schemas) {
+		for _, f := range schemas[position].Fields {
+			privacy[f.Name] = f.Privacy
+		}
+	}
+
+	return privacy
+}
+
+// writeSchemaFields converts the schema fields, adding the privacy which is not part of the SDK model.
+func writeSchemaFields(ctx context.Context, fields []*prowlarr.Field, privacy map[string]string, diags *diag.Diagnostics) types.Set {
+	schemaFields := make([]SchemaField, len(fields))
+	for n, f := range fields {
+		schemaFields[n].write(ctx, f, diags)
+		schemaFields[n].Privacy = types.StringNull()
+
+		if value, ok := privacy[f.GetName()]; ok {
+			schemaFields[n].Privacy = types.StringValue(value)
+		}
+	}
+
+	set, tempDiag := types.SetValueFrom(ctx, IndexerSchemaDataSource{}.getFieldSchema().Type(), schemaFields)
+	diags.Append(tempDiag...)
+
+	return set
 }
 
 func (d *IndexerSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -111,6 +214,11 @@ func (d *IndexerSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					Attributes: d.getFieldSchema().Attributes,
 				},
 			},
+			"capabilities": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Indexer capabilities.",
+				Attributes:          d.getCapabilitiesSchema().Attributes,
+			},
 		},
 	}
 }
@@ -122,14 +230,127 @@ func (d IndexerSchemaDataSource) getFieldSchema() schema.Schema {
 				MarkdownDescription: "Field name.",
 				Computed:            true,
 			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Field label.",
+				Computed:            true,
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Field description.",
 				Computed:            true,
+				DeprecationMessage:  "Use help_text instead.",
+			},
+			"help_text": schema.StringAttribute{
+				MarkdownDescription: "Field help text.",
+				Computed:            true,
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Field type.",
 				Computed:            true,
 			},
+			"default": schema.StringAttribute{
+				MarkdownDescription: "Default value, in the same format of the indexer `settings`.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Field privacy (e.g. `apiKey` or `password` for sensitive fields).",
+				Computed:            true,
+			},
+			"unit": schema.StringAttribute{
+				MarkdownDescription: "Field unit.",
+				Computed:            true,
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "Field order.",
+				Computed:            true,
+			},
+			"advanced": schema.BoolAttribute{
+				MarkdownDescription: "Advanced flag.",
+				Computed:            true,
+			},
+			"select_options": schema.ListNestedAttribute{
+				MarkdownDescription: "Select options.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Option name.",
+							Computed:            true,
+						},
+						"value": schema.Int64Attribute{
+							MarkdownDescription: "Option value.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d IndexerSchemaDataSource) getCapabilitiesSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"limits_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of results per query.",
+				Computed:            true,
+			},
+			"limits_default": schema.Int64Attribute{
+				MarkdownDescription: "Default number of results per query.",
+				Computed:            true,
+			},
+			"supports_raw_search": schema.BoolAttribute{
+				MarkdownDescription: "Raw search support flag.",
+				Computed:            true,
+			},
+			"search_params": schema.SetAttribute{
+				MarkdownDescription: "Supported search parameters.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tv_search_params": schema.SetAttribute{
+				MarkdownDescription: "Supported TV search parameters.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"movie_search_params": schema.SetAttribute{
+				MarkdownDescription: "Supported movie search parameters.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"music_search_params": schema.SetAttribute{
+				MarkdownDescription: "Supported music search parameters.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"book_search_params": schema.SetAttribute{
+				MarkdownDescription: "Supported book search parameters.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"categories": schema.SetNestedAttribute{
+				MarkdownDescription: "Supported categories, including subcategories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Category ID.",
+							Computed:            true,
+						},
+						"parent_id": schema.Int64Attribute{
+							MarkdownDescription: "Parent category ID, null for main categories.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Category name.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Category description.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -150,24 +371,24 @@ func (d *IndexerSchemaDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Get indexers current value
-	response, _, err := d.client.IndexerApi.ListIndexerSchema(ctx).Execute()
+	response, httpResp, err := d.client.IndexerApi.ListIndexerSchema(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
 
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, data.Name.ValueString(), response, httpResp, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+indexerSchemaDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (i *IndexerSchema) find(ctx context.Context, name string, schemas []*prowlarr.IndexerResource, diags *diag.Diagnostics) {
+func (i *IndexerSchema) find(ctx context.Context, name string, schemas []*prowlarr.IndexerResource, httpResp *http.Response, diags *diag.Diagnostics) {
 	for id, indexer := range schemas {
 		if indexer.GetName() == name {
 			i.ID = types.Int64Value(int64(id))
-			i.write(ctx, indexer, diags)
+			i.write(ctx, indexer, decodeSchemaFieldPrivacy(httpResp, id, indexerSchemaDataSourceName, diags), diags)
 
 			return
 		}
@@ -176,7 +397,7 @@ func (i *IndexerSchema) find(ctx context.Context, name string, schemas []*prowla
 	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(indexerSchemaDataSourceName, "name", name))
 }
 
-func (i *IndexerSchema) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+func (i *IndexerSchema) write(ctx context.Context, indexer *prowlarr.IndexerResource, fieldPrivacy map[string]string, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
 	i.ConfigContract = types.StringValue(indexer.GetConfigContract())
@@ -188,21 +409,93 @@ func (i *IndexerSchema) write(ctx context.Context, indexer *prowlarr.IndexerReso
 	i.Language = types.StringValue(indexer.GetLanguage())
 	i.Privacy = types.StringValue(string(indexer.GetPrivacy()))
 
-	fields := make([]SchemaField, len(indexer.GetFields()))
-	for n, f := range indexer.GetFields() {
-		fields[n].write(f)
-	}
+	i.Fields = writeSchemaFields(ctx, indexer.GetFields(), fieldPrivacy, diags)
 
-	i.Fields, tempDiag = types.SetValueFrom(ctx, IndexerSchemaDataSource{}.getFieldSchema().Type(), fields)
+	var capabilities SchemaCapabilities
+
+	capabilities.write(ctx, indexer.GetCapabilities(), diags)
+	i.Capabilities, tempDiag = types.ObjectValueFrom(ctx, IndexerSchemaDataSource{}.getCapabilitiesSchema().Type().(attr.TypeWithAttributeTypes).AttributeTypes(), capabilities)
 	diags.Append(tempDiag...)
+
 	i.IndexerURLs, tempDiag = types.SetValueFrom(ctx, types.StringType, indexer.GetIndexerUrls())
 	diags.Append(tempDiag...)
 	i.LegacyURLs, tempDiag = types.SetValueFrom(ctx, types.StringType, indexer.GetLegacyUrls())
 	diags.Append(tempDiag...)
 }
 
-func (f *SchemaField) write(field *prowlarr.Field) {
+func (f *SchemaField) write(ctx context.Context, field *prowlarr.Field, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
 	f.Name = types.StringValue(field.GetName())
+	f.Label = types.StringValue(field.GetLabel())
 	f.Description = types.StringValue(field.GetHelpText())
+	f.HelpText = types.StringValue(field.GetHelpText())
 	f.Type = types.StringValue(field.GetType())
+	f.Default = helpers.SettingFromFieldValue(field, types.StringNull())
+	f.Unit = types.StringValue(field.GetUnit())
+	f.Order = types.Int64Value(int64(field.GetOrder()))
+	f.Advanced = types.BoolValue(field.GetAdvanced())
+
+	options := make([]SchemaSelectOption, len(field.GetSelectOptions()))
+	for n, o := range field.GetSelectOptions() {
+		options[n].Name = types.StringValue(o.GetName())
+		options[n].Value = types.Int64Value(int64(o.GetValue()))
+	}
+
+	f.SelectOptions, tempDiag = types.ListValueFrom(ctx, SchemaSelectOption{}.getType(), options)
+	diags.Append(tempDiag...)
+}
+
+func (c *SchemaCapabilities) write(ctx context.Context, capabilities prowlarr.IndexerCapabilityResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	c.LimitsMax = types.Int64Value(int64(capabilities.GetLimitsMax()))
+	c.LimitsDefault = types.Int64Value(int64(capabilities.GetLimitsDefault()))
+	c.SupportsRawSearch = types.BoolValue(capabilities.GetSupportsRawSearch())
+
+	c.SearchParams, tempDiag = types.SetValueFrom(ctx, types.StringType, searchParams(capabilities.GetSearchParams()))
+	diags.Append(tempDiag...)
+	c.TVSearchParams, tempDiag = types.SetValueFrom(ctx, types.StringType, searchParams(capabilities.GetTvSearchParams()))
+	diags.Append(tempDiag...)
+	c.MovieSearchParams, tempDiag = types.SetValueFrom(ctx, types.StringType, searchParams(capabilities.GetMovieSearchParams()))
+	diags.Append(tempDiag...)
+	c.MusicSearchParams, tempDiag = types.SetValueFrom(ctx, types.StringType, searchParams(capabilities.GetMusicSearchParams()))
+	diags.Append(tempDiag...)
+	c.BookSearchParams, tempDiag = types.SetValueFrom(ctx, types.StringType, searchParams(capabilities.GetBookSearchParams()))
+	diags.Append(tempDiag...)
+
+	categories := writeSchemaCategories(capabilities.GetCategories(), types.Int64Null())
+	c.Categories, tempDiag = types.SetValueFrom(ctx, SchemaCategory{}.getType(), categories)
+	diags.Append(tempDiag...)
+}
+
+// writeSchemaCategories flattens the categories tree, keeping track of the parent.
+func writeSchemaCategories(categories []*prowlarr.IndexerCategory, parentID types.Int64) []SchemaCategory {
+	list := make([]SchemaCategory, 0, len(categories))
+
+	for _, c := range categories {
+		id := types.Int64Value(int64(c.GetId()))
+		list = append(list, SchemaCategory{
+			ID:          id,
+			ParentID:    parentID,
+			Name:        types.StringValue(c.GetName()),
+			Description: types.StringValue(c.GetDescription()),
+		})
+		list = append(list, writeSchemaCategories(c.GetSubCategories(), id)...)
+	}
+
+	return list
+}
+
+// searchParams converts the search parameters enums to strings.
+func searchParams[T ~string](params []*T) []string {
+	list := make([]string, 0, len(params))
+
+	for _, p := range params {
+		if p != nil {
+			list = append(list, string(*p))
+		}
+	}
+
+	return list
 }
@@ -31,6 +31,8 @@ func TestAccIndexerSchemaDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.prowlarr_indexer_schema.test", "id"),
 					resource.TestCheckResourceAttr("data.prowlarr_indexer_schema.test", "name", "AlphaRatio"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_indexer_schema.test", "fields.*", map[string]string{"name": "baseUrl"}),
+					resource.TestCheckResourceAttrSet("data.prowlarr_indexer_schema.test", "capabilities.categories.#"),
 				),
 			},
 		},
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerTorznabResourceName   = "indexer_torznab"
+	indexerTorznabImplementation = "Torznab"
+	indexerTorznabConfigContract = "TorznabSettings"
+	indexerTorznabProtocol       = "torrent"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerTorznabResource{}
+	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorznabResource{}
+)
+
+var indexerTorznabFields = helpers.Fields{
+	Strings:          []string{"baseUrl", "apiPath", "apiKey", "additionalParameters"},
+	IntSlices:        []string{"categories"},
+	Ints:             []string{"torrentMinimumSeeders", "torrentSeedTime", "torrentPackSeedTime"},
+	IntsExceptions:   []string{"torrentBaseSettings.appMinimumSeeders", "torrentBaseSettings.seedTime", "torrentBaseSettings.packSeedTime"},
+	Floats:           []string{"torrentSeedRatio"},
+	FloatsExceptions: []string{"torrentBaseSettings.seedRatio"},
+}
+
+func NewIndexerTorznabResource() resource.Resource {
+	return &IndexerTorznabResource{}
+}
+
+// IndexerTorznabResource defines the indexer implementation.
+type IndexerTorznabResource struct {
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
+}
+
+// IndexerTorznab describes the indexer data model.
+type IndexerTorznab struct {
+	Tags                  types.Set     `tfsdk:"tags"`
+	Categories            types.Set     `tfsdk:"categories"`
+	Name                  types.String  `tfsdk:"name"`
+	Language              types.String  `tfsdk:"language"`
+	Privacy               types.String  `tfsdk:"privacy"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	APIPath               types.String  `tfsdk:"api_path"`
+	APIKey                types.String  `tfsdk:"api_key"`
+	AdditionalParameters  types.String  `tfsdk:"additional_parameters"`
+	TorrentSeedRatio      types.Float64 `tfsdk:"seed_ratio"`
+	TorrentMinimumSeeders types.Int64   `tfsdk:"minimum_seeders"`
+	TorrentSeedTime       types.Int64   `tfsdk:"seed_time"`
+	TorrentPackSeedTime   types.Int64   `tfsdk:"pack_seed_time"`
+	AppProfileID          types.Int64   `tfsdk:"app_profile_id"`
+	Priority              types.Int64   `tfsdk:"priority"`
+	ID                    types.Int64   `tfsdk:"id"`
+	Enable                types.Bool    `tfsdk:"enable"`
+	ForceSave             types.Bool    `tfsdk:"force_save"`
+	TestOnApply           types.Bool    `tfsdk:"test_on_apply"`
+}
+
+func (i IndexerTorznab) toIndexer() *Indexer {
+	return &Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Fields:         types.SetNull(IndexerResource{}.getFieldSchema().Type()),
+		ConfigContract: types.StringValue(indexerTorznabConfigContract),
+		Implementation: types.StringValue(indexerTorznabImplementation),
+		Protocol:       types.StringValue(indexerTorznabProtocol),
+	}
+}
+
+func (i *IndexerTorznab) fromIndexer(indexer *Indexer) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+}
+
+func (r *IndexerTorznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerTorznabResourceName
+}
+
+func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) and [Torznab](https://wiki.servarr.com/prowlarr/supported#torznab).",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
+			// Field values
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL.",
+				Required:            true,
+			},
+			"api_path": schema.StringAttribute{
+				MarkdownDescription: "API path.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"additional_parameters": schema.StringAttribute{
+				MarkdownDescription: "Additional parameters.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Categories.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"minimum_seeders": schema.Int64Attribute{
+				MarkdownDescription: "Minimum seeders required by the applications to grab.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Seed ratio.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Seed time in minutes.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pack_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Season pack seed time in minutes.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
+	}
+}
+
+func (r *IndexerTorznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
+func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerTorznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Create, indexerTorznabResourceName, err) {
+		response, _, err = r.client.IndexerApi.CreateIndexer(helpers.ContextWithForceSave(ctx)).IndexerResource(*request).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, indexerTorznabResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerTorznabResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerTorznab current value
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerTorznabResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &indexer.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update IndexerTorznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, indexer.ForceSave.ValueBool(), helpers.Update, indexerTorznabResourceName, err) {
+		response, _, err = r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).ForceSave(true).Execute()
+	}
+
+	if err != nil {
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, indexerTorznabResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, indexerTorznabResourceName, indexer.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.IndexerApi.TestIndexer(ctx).IndexerResource(*request).Execute()
+
+		return err
+	})
+}
+
+func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerTorznab current value
+	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerTorznabResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
+}
+
+func (i *IndexerTorznab) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+	i.Categories = types.SetValueMust(types.Int64Type, nil)
+	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerTorznabFields)
+}
+
+func (i *IndexerTorznab) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	indexer := i.toIndexer().read(ctx, diags)
+	indexer.SetFields(helpers.ReadFields(ctx, i, indexerTorznabFields))
+
+	return indexer
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerTorznabResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerTorznabResourceConfig("resourceTorznabTest", "/api") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerTorznabResourceConfig("resourceTorznabTest", "/api"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_torznab.test", "api_path", "/api"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_torznab.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerTorznabResourceConfig("resourceTorznabTest", "/api") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerTorznabResourceConfig("resourceTorznabTest", "/api/v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_torznab.test", "api_path", "/api/v2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "prowlarr_indexer_torznab.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerTorznabResourceConfig(name, path string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_torznab" "test" {
+		enable = false
+		name = "%s"
+		base_url = "https://example.torznab.com"
+		api_path = "%s"
+		api_key = "Key"
+		categories = [2000]
+		minimum_seeders = 1
+		seed_ratio = 0.5
+		force_save = true
+	}`, name, path)
+}
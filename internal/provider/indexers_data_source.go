@@ -92,6 +92,11 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							MarkdownDescription: "Privacy.",
 							Computed:            true,
 						},
+						"indexer_urls": schema.SetAttribute{
+							MarkdownDescription: "Available indexer URLs.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Indexer ID.",
 							Computed:            true,
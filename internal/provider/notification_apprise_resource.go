@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,7 +37,9 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // NotificationApprise describes the notification data model.
@@ -57,6 +60,8 @@ type NotificationApprise struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -156,6 +161,14 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"notification_type": schema.Int64Attribute{
 				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
@@ -203,11 +216,17 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationAppriseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationApprise
@@ -218,12 +237,23 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Create, notificationAppriseResourceName, err) {
+		response, _, err = r.client.NotificationApi.CreateNotification(helpers.ContextWithForceSave(ctx)).NotificationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, notificationAppriseResourceName, err)
 
 		return
 	}
@@ -232,6 +262,13 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationAppriseResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -245,9 +282,9 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
+		helpers.HandleReadError(ctx, notificationAppriseResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -268,12 +305,23 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Update, notificationAppriseResourceName, err) {
+		response, _, err = r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, notificationAppriseResourceName, err)
 
 		return
 	}
@@ -282,6 +330,13 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationAppriseResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
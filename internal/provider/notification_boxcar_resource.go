@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationBoxcarResource{}
 	_ resource.ResourceWithImportState = &NotificationBoxcarResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationBoxcarResource{}
 )
 
 func NewNotificationBoxcarResource() resource.Resource {
@@ -34,7 +35,9 @@ func NewNotificationBoxcarResource() resource.Resource {
 
 // NotificationBoxcarResource defines the notification implementation.
 type NotificationBoxcarResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // NotificationBoxcar describes the notification data model.
@@ -49,6 +52,8 @@ type NotificationBoxcar struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationBoxcar) toNotification() *Notification {
@@ -136,6 +141,14 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"token": schema.StringAttribute{
 				MarkdownDescription: "Token.",
@@ -147,11 +160,17 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *NotificationBoxcarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *NotificationBoxcarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationBoxcar
@@ -162,12 +181,23 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationBoxcar
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Create, notificationBoxcarResourceName, err) {
+		response, _, err = r.client.NotificationApi.CreateNotification(helpers.ContextWithForceSave(ctx)).NotificationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationBoxcarResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, notificationBoxcarResourceName, err)
 
 		return
 	}
@@ -176,6 +206,13 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationBoxcarResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationBoxcarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -189,9 +226,9 @@ func (r *NotificationBoxcarResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get NotificationBoxcar current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationBoxcarResourceName, err))
+		helpers.HandleReadError(ctx, notificationBoxcarResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -212,12 +249,23 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationBoxcar
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Update, notificationBoxcarResourceName, err) {
+		response, _, err = r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationBoxcarResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, notificationBoxcarResourceName, err)
 
 		return
 	}
@@ -226,6 +274,13 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationBoxcarResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationBoxcarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
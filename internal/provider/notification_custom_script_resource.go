@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -35,7 +36,9 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // NotificationCustomScript describes the notification data model.
@@ -49,6 +52,8 @@ type NotificationCustomScript struct {
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
@@ -123,6 +128,14 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"arguments": schema.StringAttribute{
 				MarkdownDescription: "Arguments.",
@@ -138,11 +151,17 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 }
 
 func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationCustomScript
@@ -153,12 +172,23 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Create, notificationCustomScriptResourceName, err) {
+		response, _, err = r.client.NotificationApi.CreateNotification(helpers.ContextWithForceSave(ctx)).NotificationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -167,6 +197,13 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationCustomScriptResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,9 +217,9 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
+		helpers.HandleReadError(ctx, notificationCustomScriptResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -203,12 +240,23 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Update, notificationCustomScriptResourceName, err) {
+		response, _, err = r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -217,6 +265,13 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationCustomScriptResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -34,7 +35,9 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // NotificationEmail describes the notification data model.
@@ -57,6 +60,8 @@ type NotificationEmail struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -160,6 +165,14 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"require_encryption": schema.BoolAttribute{
 				MarkdownDescription: "Require encryption flag.",
@@ -212,11 +225,17 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -227,12 +246,23 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Create, notificationEmailResourceName, err) {
+		response, _, err = r.client.NotificationApi.CreateNotification(helpers.ContextWithForceSave(ctx)).NotificationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, notificationEmailResourceName, err)
 
 		return
 	}
@@ -241,6 +271,13 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationEmailResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,9 +291,9 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmailResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -277,12 +314,23 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Update, notificationEmailResourceName, err) {
+		response, _, err = r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, notificationEmailResourceName, err)
 
 		return
 	}
@@ -291,6 +339,13 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationEmailResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -26,6 +26,7 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -44,7 +45,9 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *prowlarr.APIClient
+	client      *prowlarr.APIClient
+	defaultTags *helpers.DefaultTags
+	testOnApply bool
 }
 
 // Notification describes the notification data model.
@@ -136,6 +139,275 @@ type Notification struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 }
 
+// NotificationGeneric describes the generic notification resource data model.
+type NotificationGeneric struct {
+	Tags                  types.Set    `tfsdk:"tags"`
+	FieldTags             types.Set    `tfsdk:"field_tags"`
+	ChannelTags           types.Set    `tfsdk:"channel_tags"`
+	Topics                types.Set    `tfsdk:"topics"`
+	GrabFields            types.Set    `tfsdk:"grab_fields"`
+	DeviceIds             types.Set    `tfsdk:"device_ids"`
+	Devices               types.Set    `tfsdk:"devices"`
+	To                    types.Set    `tfsdk:"to"`
+	Cc                    types.Set    `tfsdk:"cc"`
+	Bcc                   types.Set    `tfsdk:"bcc"`
+	Recipients            types.Set    `tfsdk:"recipients"`
+	DeviceNames           types.String `tfsdk:"device_names"`
+	AccessToken           types.String `tfsdk:"access_token"`
+	Host                  types.String `tfsdk:"host"`
+	InstanceName          types.String `tfsdk:"instance_name"`
+	Name                  types.String `tfsdk:"name"`
+	Implementation        types.String `tfsdk:"implementation"`
+	ConfigContract        types.String `tfsdk:"config_contract"`
+	ClickURL              types.String `tfsdk:"click_url"`
+	ConsumerSecret        types.String `tfsdk:"consumer_secret"`
+	Path                  types.String `tfsdk:"path"`
+	Arguments             types.String `tfsdk:"arguments"`
+	ConsumerKey           types.String `tfsdk:"consumer_key"`
+	ChatID                types.String `tfsdk:"chat_id"`
+	TopicID               types.String `tfsdk:"topic_id"`
+	From                  types.String `tfsdk:"from"`
+	Icon                  types.String `tfsdk:"icon"`
+	Password              types.String `tfsdk:"password"`
+	Event                 types.String `tfsdk:"event"`
+	Key                   types.String `tfsdk:"key"`
+	RefreshToken          types.String `tfsdk:"refresh_token"`
+	WebHookURL            types.String `tfsdk:"web_hook_url"`
+	Username              types.String `tfsdk:"username"`
+	UserKey               types.String `tfsdk:"user_key"`
+	Mention               types.String `tfsdk:"mention"`
+	Avatar                types.String `tfsdk:"avatar"`
+	URL                   types.String `tfsdk:"url"`
+	Token                 types.String `tfsdk:"token"`
+	Sound                 types.String `tfsdk:"sound"`
+	SignIn                types.String `tfsdk:"sign_in"`
+	Server                types.String `tfsdk:"server"`
+	SenderID              types.String `tfsdk:"sender_id"`
+	SenderNumber          types.String `tfsdk:"sender_number"`
+	ReceiverID            types.String `tfsdk:"receiver_id"`
+	BotToken              types.String `tfsdk:"bot_token"`
+	SenderDomain          types.String `tfsdk:"sender_domain"`
+	MapTo                 types.String `tfsdk:"map_to"`
+	MapFrom               types.String `tfsdk:"map_from"`
+	Channel               types.String `tfsdk:"channel"`
+	Expires               types.String `tfsdk:"expires"`
+	ServerURL             types.String `tfsdk:"server_url"`
+	AccessTokenSecret     types.String `tfsdk:"access_token_secret"`
+	APIKey                types.String `tfsdk:"api_key"`
+	AppToken              types.String `tfsdk:"app_token"`
+	Author                types.String `tfsdk:"author"`
+	AuthToken             types.String `tfsdk:"auth_token"`
+	AuthUser              types.String `tfsdk:"auth_user"`
+	ConfigurationKey      types.String `tfsdk:"configuration_key"`
+	StatelessURLs         types.String `tfsdk:"stateless_urls"`
+	BaseURL               types.String `tfsdk:"base_url"`
+	AuthUsername          types.String `tfsdk:"auth_username"`
+	AuthPassword          types.String `tfsdk:"auth_password"`
+	DisplayTime           types.Int64  `tfsdk:"display_time"`
+	ItemPriority          types.Int64  `tfsdk:"priority"`
+	Port                  types.Int64  `tfsdk:"port"`
+	Method                types.Int64  `tfsdk:"method"`
+	Retry                 types.Int64  `tfsdk:"retry"`
+	Expire                types.Int64  `tfsdk:"expire"`
+	NotificationType      types.Int64  `tfsdk:"notification_type"`
+	ID                    types.Int64  `tfsdk:"id"`
+	CleanLibrary          types.Bool   `tfsdk:"clean_library"`
+	SendSilently          types.Bool   `tfsdk:"send_silently"`
+	AlwaysUpdate          types.Bool   `tfsdk:"always_update"`
+	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
+	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	DirectMessage         types.Bool   `tfsdk:"direct_message"`
+	RequireEncryption     types.Bool   `tfsdk:"require_encryption"`
+	UseSSL                types.Bool   `tfsdk:"use_ssl"`
+	Notify                types.Bool   `tfsdk:"notify"`
+	UseEuEndpoint         types.Bool   `tfsdk:"use_eu_endpoint"`
+	UpdateLibrary         types.Bool   `tfsdk:"update_library"`
+	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
+	OnGrab                types.Bool   `tfsdk:"on_grab"`
+	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (n NotificationGeneric) toNotification() *Notification {
+	return &Notification{
+		Tags:                  n.Tags,
+		FieldTags:             n.FieldTags,
+		ChannelTags:           n.ChannelTags,
+		Topics:                n.Topics,
+		GrabFields:            n.GrabFields,
+		DeviceIds:             n.DeviceIds,
+		Devices:               n.Devices,
+		To:                    n.To,
+		Cc:                    n.Cc,
+		Bcc:                   n.Bcc,
+		Recipients:            n.Recipients,
+		DeviceNames:           n.DeviceNames,
+		AccessToken:           n.AccessToken,
+		Host:                  n.Host,
+		InstanceName:          n.InstanceName,
+		Name:                  n.Name,
+		Implementation:        n.Implementation,
+		ConfigContract:        n.ConfigContract,
+		ClickURL:              n.ClickURL,
+		ConsumerSecret:        n.ConsumerSecret,
+		Path:                  n.Path,
+		Arguments:             n.Arguments,
+		ConsumerKey:           n.ConsumerKey,
+		ChatID:                n.ChatID,
+		TopicID:               n.TopicID,
+		From:                  n.From,
+		Icon:                  n.Icon,
+		Password:              n.Password,
+		Event:                 n.Event,
+		Key:                   n.Key,
+		RefreshToken:          n.RefreshToken,
+		WebHookURL:            n.WebHookURL,
+		Username:              n.Username,
+		UserKey:               n.UserKey,
+		Mention:               n.Mention,
+		Avatar:                n.Avatar,
+		URL:                   n.URL,
+		Token:                 n.Token,
+		Sound:                 n.Sound,
+		SignIn:                n.SignIn,
+		Server:                n.Server,
+		SenderID:              n.SenderID,
+		SenderNumber:          n.SenderNumber,
+		ReceiverID:            n.ReceiverID,
+		BotToken:              n.BotToken,
+		SenderDomain:          n.SenderDomain,
+		MapTo:                 n.MapTo,
+		MapFrom:               n.MapFrom,
+		Channel:               n.Channel,
+		Expires:               n.Expires,
+		ServerURL:             n.ServerURL,
+		AccessTokenSecret:     n.AccessTokenSecret,
+		APIKey:                n.APIKey,
+		AppToken:              n.AppToken,
+		Author:                n.Author,
+		AuthToken:             n.AuthToken,
+		AuthUser:              n.AuthUser,
+		ConfigurationKey:      n.ConfigurationKey,
+		StatelessURLs:         n.StatelessURLs,
+		BaseURL:               n.BaseURL,
+		AuthUsername:          n.AuthUsername,
+		AuthPassword:          n.AuthPassword,
+		DisplayTime:           n.DisplayTime,
+		ItemPriority:          n.ItemPriority,
+		Port:                  n.Port,
+		Method:                n.Method,
+		Retry:                 n.Retry,
+		Expire:                n.Expire,
+		NotificationType:      n.NotificationType,
+		ID:                    n.ID,
+		CleanLibrary:          n.CleanLibrary,
+		SendSilently:          n.SendSilently,
+		AlwaysUpdate:          n.AlwaysUpdate,
+		OnHealthIssue:         n.OnHealthIssue,
+		OnHealthRestored:      n.OnHealthRestored,
+		DirectMessage:         n.DirectMessage,
+		RequireEncryption:     n.RequireEncryption,
+		UseSSL:                n.UseSSL,
+		Notify:                n.Notify,
+		UseEuEndpoint:         n.UseEuEndpoint,
+		UpdateLibrary:         n.UpdateLibrary,
+		IncludeHealthWarnings: n.IncludeHealthWarnings,
+		OnApplicationUpdate:   n.OnApplicationUpdate,
+		OnGrab:                n.OnGrab,
+		IncludeManualGrabs:    n.IncludeManualGrabs,
+	}
+}
+
+func (n *NotificationGeneric) fromNotification(notification *Notification) {
+	n.Tags = notification.Tags
+	n.FieldTags = notification.FieldTags
+	n.ChannelTags = notification.ChannelTags
+	n.Topics = notification.Topics
+	n.GrabFields = notification.GrabFields
+	n.DeviceIds = notification.DeviceIds
+	n.Devices = notification.Devices
+	n.To = notification.To
+	n.Cc = notification.Cc
+	n.Bcc = notification.Bcc
+	n.Recipients = notification.Recipients
+	n.DeviceNames = notification.DeviceNames
+	n.AccessToken = notification.AccessToken
+	n.Host = notification.Host
+	n.InstanceName = notification.InstanceName
+	n.Name = notification.Name
+	n.Implementation = notification.Implementation
+	n.ConfigContract = notification.ConfigContract
+	n.ClickURL = notification.ClickURL
+	n.ConsumerSecret = notification.ConsumerSecret
+	n.Path = notification.Path
+	n.Arguments = notification.Arguments
+	n.ConsumerKey = notification.ConsumerKey
+	n.ChatID = notification.ChatID
+	n.TopicID = notification.TopicID
+	n.From = notification.From
+	n.Icon = notification.Icon
+	n.Password = notification.Password
+	n.Event = notification.Event
+	n.Key = notification.Key
+	n.RefreshToken = notification.RefreshToken
+	n.WebHookURL = notification.WebHookURL
+	n.Username = notification.Username
+	n.UserKey = notification.UserKey
+	n.Mention = notification.Mention
+	n.Avatar = notification.Avatar
+	n.URL = notification.URL
+	n.Token = notification.Token
+	n.Sound = notification.Sound
+	n.SignIn = notification.SignIn
+	n.Server = notification.Server
+	n.SenderID = notification.SenderID
+	n.SenderNumber = notification.SenderNumber
+	n.ReceiverID = notification.ReceiverID
+	n.BotToken = notification.BotToken
+	n.SenderDomain = notification.SenderDomain
+	n.MapTo = notification.MapTo
+	n.MapFrom = notification.MapFrom
+	n.Channel = notification.Channel
+	n.Expires = notification.Expires
+	n.ServerURL = notification.ServerURL
+	n.AccessTokenSecret = notification.AccessTokenSecret
+	n.APIKey = notification.APIKey
+	n.AppToken = notification.AppToken
+	n.Author = notification.Author
+	n.AuthToken = notification.AuthToken
+	n.AuthUser = notification.AuthUser
+	n.ConfigurationKey = notification.ConfigurationKey
+	n.StatelessURLs = notification.StatelessURLs
+	n.BaseURL = notification.BaseURL
+	n.AuthUsername = notification.AuthUsername
+	n.AuthPassword = notification.AuthPassword
+	n.DisplayTime = notification.DisplayTime
+	n.ItemPriority = notification.ItemPriority
+	n.Port = notification.Port
+	n.Method = notification.Method
+	n.Retry = notification.Retry
+	n.Expire = notification.Expire
+	n.NotificationType = notification.NotificationType
+	n.ID = notification.ID
+	n.CleanLibrary = notification.CleanLibrary
+	n.SendSilently = notification.SendSilently
+	n.AlwaysUpdate = notification.AlwaysUpdate
+	n.OnHealthIssue = notification.OnHealthIssue
+	n.OnHealthRestored = notification.OnHealthRestored
+	n.DirectMessage = notification.DirectMessage
+	n.RequireEncryption = notification.RequireEncryption
+	n.UseSSL = notification.UseSSL
+	n.Notify = notification.Notify
+	n.UseEuEndpoint = notification.UseEuEndpoint
+	n.UpdateLibrary = notification.UpdateLibrary
+	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
+	n.OnApplicationUpdate = notification.OnApplicationUpdate
+	n.OnGrab = notification.OnGrab
+	n.IncludeManualGrabs = notification.IncludeManualGrabs
+}
+
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -289,6 +561,14 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: helpers.ForceSaveDescription,
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: helpers.TestOnApplyDescription,
+				Optional:            true,
+			},
 			// Field values
 			"always_update": schema.BoolAttribute{
 				MarkdownDescription: "Always update flag.",
@@ -688,14 +968,20 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
+	if data := helpers.ResourceProviderData(ctx, req, resp); data != nil {
+		r.client = data.Client
+		r.defaultTags = data.DefaultTags
+		r.testOnApply = data.TestOnApply
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.ModifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *NotificationGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -703,28 +989,43 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Create, notificationResourceName, err) {
+		response, _, err = r.client.NotificationApi.CreateNotification(helpers.ContextWithForceSave(ctx)).NotificationResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, notificationResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
+
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return err
+	})
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var notification *Notification
+	var notification *NotificationGeneric
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -733,25 +1034,22 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
+		helpers.HandleReadError(ctx, notificationResourceName, httpResp, err, resp)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
-
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var notification *Notification
+	var notification *NotificationGeneric
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -759,23 +1057,38 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// Merge provider default tags
+	resp.Diagnostics.Append(r.defaultTags.Apply(ctx, req.Config, &notification.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if helpers.IsForceSaveNeeded(ctx, &resp.Diagnostics, resp.State.Schema, notification.ForceSave.ValueBool(), helpers.Update, notificationResourceName, err) {
+		response, _, err = r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).ForceSave(true).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, notificationResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
 
-	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	// Test the saved configuration
+	helpers.RunTestOnApply(ctx, &resp.Diagnostics, resp.State.Schema, notificationResourceName, notification.TestOnApply, r.testOnApply, func() error {
+		_, err := r.client.NotificationApi.TestNotification(ctx).NotificationResource(*request).Execute()
+
+		return err
+	})
 }
 
 func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -804,6 +1117,18 @@ func (r *NotificationResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
+func (n *NotificationGeneric) write(ctx context.Context, notification *prowlarr.NotificationResource, diags *diag.Diagnostics) {
+	// this is needed because of many empty fields are unknown in both plan and read
+	var state Notification
+
+	state.write(ctx, notification, diags)
+	n.fromNotification(&state)
+}
+
+func (n *NotificationGeneric) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.NotificationResource {
+	return n.toNotification().read(ctx, diags)
+}
+
 func (n *Notification) write(ctx context.Context, notification *prowlarr.NotificationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
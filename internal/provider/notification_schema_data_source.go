@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const notificationSchemaDataSourceName = "notification_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationSchemaDataSource{}
+
+func NewNotificationSchemaDataSource() datasource.DataSource {
+	return &NotificationSchemaDataSource{}
+}
+
+// NotificationSchemaDataSource defines the notification schema implementation.
+type NotificationSchemaDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// NotificationSchema describes the notification schema data model.
+type NotificationSchema struct {
+	Fields                      types.Set    `tfsdk:"fields"`
+	Implementation              types.String `tfsdk:"implementation"`
+	ImplementationName          types.String `tfsdk:"implementation_name"`
+	ConfigContract              types.String `tfsdk:"config_contract"`
+	InfoLink                    types.String `tfsdk:"info_link"`
+	ID                          types.Int64  `tfsdk:"id"`
+	SupportsOnGrab              types.Bool   `tfsdk:"supports_on_grab"`
+	SupportsOnHealthIssue       types.Bool   `tfsdk:"supports_on_health_issue"`
+	SupportsOnHealthRestored    types.Bool   `tfsdk:"supports_on_health_restored"`
+	SupportsOnApplicationUpdate types.Bool   `tfsdk:"supports_on_application_update"`
+}
+
+func (d *NotificationSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + notificationSchemaDataSourceName
+}
+
+func (d *NotificationSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Notifications -->Notification schema definition, to be used with the generic [Notification](../resources/notification).",
+		Attributes: map[string]schema.Attribute{
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Notification implementation name.",
+				Required:            true,
+			},
+			"implementation_name": schema.StringAttribute{
+				MarkdownDescription: "Notification implementation display name.",
+				Computed:            true,
+			},
+			"config_contract": schema.StringAttribute{
+				MarkdownDescription: "Notification configuration template.",
+				Computed:            true,
+			},
+			"info_link": schema.StringAttribute{
+				MarkdownDescription: "Link to the notification documentation.",
+				Computed:            true,
+			},
+			"supports_on_grab": schema.BoolAttribute{
+				MarkdownDescription: "Supports on grab flag.",
+				Computed:            true,
+			},
+			"supports_on_health_issue": schema.BoolAttribute{
+				MarkdownDescription: "Supports on health issue flag.",
+				Computed:            true,
+			},
+			"supports_on_health_restored": schema.BoolAttribute{
+				MarkdownDescription: "Supports on health restored flag.",
+				Computed:            true,
+			},
+			"supports_on_application_update": schema.BoolAttribute{
+				MarkdownDescription: "Supports on application update flag.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Schema ID.",
+				Computed:            true,
+			},
+			"fields": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set of configuration fields.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IndexerSchemaDataSource{}.getFieldSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *NotificationSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *NotificationSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NotificationSchema
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get notification schemas current value
+	response, httpResp, err := d.client.NotificationApi.ListNotificationSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSchemaDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Implementation.ValueString(), response, httpResp, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+notificationSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *NotificationSchema) find(ctx context.Context, implementation string, schemas []*prowlarr.NotificationResource, httpResp *http.Response, diags *diag.Diagnostics) {
+	for id, item := range schemas {
+		if item.GetImplementation() == implementation {
+			s.ID = types.Int64Value(int64(id))
+			s.write(ctx, item, decodeSchemaFieldPrivacy(httpResp, id, notificationSchemaDataSourceName, diags), diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(notificationSchemaDataSourceName, "implementation", implementation))
+}
+
+func (s *NotificationSchema) write(ctx context.Context, item *prowlarr.NotificationResource, fieldPrivacy map[string]string, diags *diag.Diagnostics) {
+	s.Implementation = types.StringValue(item.GetImplementation())
+	s.ImplementationName = types.StringValue(item.GetImplementationName())
+	s.ConfigContract = types.StringValue(item.GetConfigContract())
+	s.InfoLink = types.StringValue(item.GetInfoLink())
+	s.SupportsOnGrab = types.BoolValue(item.GetSupportsOnGrab())
+	s.SupportsOnHealthIssue = types.BoolValue(item.GetSupportsOnHealthIssue())
+	s.SupportsOnHealthRestored = types.BoolValue(item.GetSupportsOnHealthRestored())
+	s.SupportsOnApplicationUpdate = types.BoolValue(item.GetSupportsOnApplicationUpdate())
+	s.Fields = writeSchemaFields(ctx, item.GetFields(), fieldPrivacy, diags)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccNotificationSchemaDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccNotificationSchemaDataSourceConfig("error"),
+				ExpectError: regexp.MustCompile("Unable to find notification_schema"),
+			},
+			// Read testing
+			{
+				Config: testAccNotificationSchemaDataSourceConfig("Discord"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_notification_schema.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_notification_schema.test", "implementation", "Discord"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prowlarr_notification_schema.test", "fields.*", map[string]string{"name": "webHookUrl"}),
+					resource.TestCheckResourceAttr("data.prowlarr_notification_schema.test", "supports_on_grab", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSchemaDataSourceConfig(implementation string) string {
+	return fmt.Sprintf(`
+	data "prowlarr_notification_schema" "test" {
+		implementation = "%s"
+	}
+	`, implementation)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const notificationSchemasDataSourceName = "notification_schemas"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationSchemasDataSource{}
+
+func NewNotificationSchemasDataSource() datasource.DataSource {
+	return &NotificationSchemasDataSource{}
+}
+
+// NotificationSchemasDataSource defines the notification schemas implementation.
+type NotificationSchemasDataSource struct {
+	client *prowlarr.APIClient
+}
+
+// NotificationSchemas describes the notification schemas data model.
+type NotificationSchemas struct {
+	NotificationSchemas types.List   `tfsdk:"notification_schemas"`
+	ID                  types.String `tfsdk:"id"`
+}
+
+func (d *NotificationSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + notificationSchemasDataSourceName
+}
+
+func (d *NotificationSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Notifications -->List all available [Notification Schemas](../data-sources/notification_schema).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"notification_schemas": schema.ListAttribute{
+				MarkdownDescription: "Notification implementation list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *NotificationSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *NotificationSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get notification schemas current value
+	response, _, err := d.client.NotificationApi.ListNotificationSchema(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSchemasDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+notificationSchemasDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := make([]string, len(response))
+	for i, s := range response {
+		schemas[i] = s.GetImplementation()
+	}
+
+	schemaList, diags := types.ListValueFrom(ctx, types.StringType, schemas)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, NotificationSchemas{NotificationSchemas: schemaList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -33,6 +33,11 @@ func TestAccNotificationWebhookResource(t *testing.T) {
 				Config:      testAccNotificationWebhookResourceConfig("resourceWebhookTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Test on apply failure
+			{
+				Config:      testAccNotificationWebhookResourceTestOnApplyConfig("resourceWebhookTest"),
+				ExpectError: regexp.MustCompile("Test of notification_webhook failed"),
+			},
 			// Update and Read testing
 			{
 				Config: testAccNotificationWebhookResourceConfig("resourceWebhookTest", "true"),
@@ -64,3 +69,18 @@ func testAccNotificationWebhookResourceConfig(name, upgrade string) string {
 		method = 1
 	}`, upgrade, name)
 }
+
+func testAccNotificationWebhookResourceTestOnApplyConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_notification_webhook" "test" {
+		on_health_issue                    = false
+		on_application_update              = false
+	  
+		include_health_warnings = false
+		name                    = "%s"
+	  
+		url = "http://transmission:9091"
+		method = 1
+		test_on_apply = true
+	}`, name)
+}
@@ -2,15 +2,35 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// define default values for client retries.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1
+	defaultRetryWaitMax = 30
 )
 
 // needed for tf debug mode
@@ -29,9 +49,31 @@ type ProwlarrProvider struct {
 
 // Prowlarr describes the provider data model.
 type Prowlarr struct {
-	APIKey        types.String `tfsdk:"api_key"`
-	Authorization types.String `tfsdk:"authorization"`
-	URL           types.String `tfsdk:"url"`
+	APIKey              types.String `tfsdk:"api_key"`
+	Authorization       types.String `tfsdk:"authorization"`
+	URL                 types.String `tfsdk:"url"`
+	URLBase             types.String `tfsdk:"url_base"`
+	APIKeyFile          types.String `tfsdk:"api_key_file"`
+	ConfigXMLPath       types.String `tfsdk:"config_xml_path"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin        types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax        types.Int64  `tfsdk:"retry_wait_max"`
+	WaitForReadyTimeout types.Int64  `tfsdk:"wait_for_ready_timeout"`
+	CheckSystemStatus   types.Bool   `tfsdk:"check_system_status"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+	CACertificate       types.String `tfsdk:"ca_certificate"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	ExtraHeaders        types.Map    `tfsdk:"extra_headers"`
+	BasicAuth           types.Object `tfsdk:"basic_auth"`
+	DefaultTags         types.Set    `tfsdk:"default_tags"`
+}
+
+// BasicAuth is part of Prowlarr.
+type BasicAuth struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
 }
 
 func (p *ProwlarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,13 +91,107 @@ func (p *ProwlarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Sensitive:           true,
 			},
 			"authorization": schema.StringAttribute{
-				MarkdownDescription: "Token for token-based authentication with Prowlarr. This is an alternative to using an API key. Set this via the `PROWLARR_AUTHORIZATION` environment variable. One of `authorization` or `api_key` must be provided, but not both.",
+				MarkdownDescription: "Token for token-based authentication with Prowlarr. This is an alternative to using an API key. Set this via the `PROWLARR_AUTHORIZATION` environment variable. One of `authorization`, `api_key`, `api_key_file` or `config_xml_path` must be provided, but not more than one.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key for Prowlarr authentication (e.g. a Docker or Kubernetes secret). Can be specified via the `PROWLARR_API_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"config_xml_path": schema.StringAttribute{
+				MarkdownDescription: "Path to Prowlarr `config.xml` file, used to read the API key. When `url` is not set, `http://localhost` with the configured `Port` is used; when `url_base` is not set, the configured `UrlBase` is used. Can be specified via the `PROWLARR_CONFIG_XML_PATH` environment variable.",
+				Optional:            true,
+			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Prowlarr URL with protocol and port (e.g. `https://test.prowlarr.com:9696`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `PROWLARR_URL` environment variable.",
+				MarkdownDescription: "Full Prowlarr URL with protocol, port and optional URL base (e.g. `https://test.prowlarr.com:9696` or `https://home.example/prowlarr`). You should **NOT** supply the API path (`/api`), the SDK will use the appropriate paths. Can be specified via the `PROWLARR_URL` environment variable.",
+				Optional:            true,
+			},
+			"url_base": schema.StringAttribute{
+				MarkdownDescription: "URL base, as configured in Prowlarr host `url_base` (e.g. `/prowlarr`). Alternative to a path in `url`. Can be specified via the `PROWLARR_URL_BASE` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for idempotent requests failing with connection errors or `5xx` responses. Defaults to `3`. Can be specified via the `PROWLARR_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying, doubled on each attempt. It must be at least `1` and not greater than `retry_wait_max`. Defaults to `1`. Can be specified via the `PROWLARR_RETRY_WAIT_MIN` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying. It must be at least `1`. Defaults to `30`. Can be specified via the `PROWLARR_RETRY_WAIT_MAX` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"wait_for_ready_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait for Prowlarr to be ready during provider configuration. Defaults to `0` (no wait). Can be specified via the `PROWLARR_WAIT_FOR_READY_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"check_system_status": schema.BoolAttribute{
+				MarkdownDescription: "Check the Prowlarr system status during provider configuration, reporting authentication errors, unreachable host or a URL not pointing to Prowlarr before any resource is processed. The Prowlarr version is also used to report attributes unsupported by the connected instance. Can be specified via the `PROWLARR_CHECK_SYSTEM_STATUS` environment variable.",
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Default for the `test_on_apply` attribute of applications, download clients, notifications, indexers and indexer proxies. Can be specified via the `PROWLARR_TEST_ON_APPLY` environment variable.",
+				Optional:            true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate used to verify the Prowlarr server certificate, in addition to the system ones. Can be specified via the `PROWLARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for TLS authentication. Requires `client_key`. Can be specified via the `PROWLARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate key for TLS authentication. Requires `client_certificate`. Can be specified via the `PROWLARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Prowlarr server certificate. Can be specified via the `PROWLARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers added to each request (e.g. a reverse proxy service token). They cannot override the authentication headers.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tag labels applied to every taggable resource (applications, download clients, indexers, indexer proxies and notifications), in addition to the resource `tags`. Missing tags are created on apply.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "Basic authentication for a reverse proxy in front of Prowlarr, sent in the `Authorization` header. It conflicts with `authorization`. Can be specified via the `PROWLARR_BASIC_AUTH_USERNAME` and `PROWLARR_BASIC_AUTH_PASSWORD` environment variables.",
 				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("authorization")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Username.",
+						Required:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
 			},
 		},
 	}
@@ -70,6 +206,23 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Prowlarr config.xml can provide API key, port and URL base
+	var configXML *helpers.ConfigXML
+
+	if configXMLPath := getStringConfig(data.ConfigXMLPath, "PROWLARR_CONFIG_XML_PATH"); configXMLPath != "" {
+		var err error
+
+		configXML, err = helpers.ReadConfigXML(configXMLPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read config.xml",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
 	// User must provide URL to the provider
 	if data.URL.IsUnknown() {
 		// Cannot connect to client with an unknown value
@@ -88,6 +241,10 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		url = data.URL.ValueString()
 	}
 
+	if url == "" && configXML != nil && configXML.Port != 0 {
+		url = fmt.Sprintf("http://localhost:%d", configXML.Port)
+	}
+
 	if url == "" {
 		// Error vs warning - empty value must stop execution
 		resp.Diagnostics.AddError(
@@ -98,6 +255,21 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	urlBase := getStringConfig(data.URLBase, "PROWLARR_URL_BASE")
+	if urlBase == "" && configXML != nil {
+		urlBase = configXML.URLBase
+	}
+
+	url, err := helpers.NormalizeURL(url, urlBase)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid URL",
+			err.Error(),
+		)
+
+		return
+	}
+
 	// User must provide API key to the provider
 	if data.APIKey.IsUnknown() {
 		// Cannot connect to client with an unknown value
@@ -123,19 +295,81 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		authorization = data.Authorization.ValueString()
 	}
 
-	if key == "" && authorization == "" {
+	// collect all the configured credential sources, only one is allowed
+	credentials := make([]string, 0, 4)
+
+	if key != "" {
+		credentials = append(credentials, "'api_key'")
+	}
+
+	if authorization != "" {
+		credentials = append(credentials, "'authorization'")
+	}
+
+	if keyFile := getStringConfig(data.APIKeyFile, "PROWLARR_API_KEY_FILE"); keyFile != "" {
+		credentials = append(credentials, "'api_key_file'")
+
+		key, err = helpers.ReadAPIKeyFile(keyFile)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read API key file",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
+	if configXML != nil {
+		credentials = append(credentials, "'config_xml_path'")
+		key = configXML.APIKey
+	}
+
+	if len(credentials) == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Authentication Credentials",
-			"Both 'api_key' and 'authorization' are empty. You must provide either an API key or an authorization token for Prowlarr authentication.",
+			"'api_key', 'authorization', 'api_key_file' and 'config_xml_path' are empty. You must provide either an API key or an authorization token for Prowlarr authentication.",
 		)
 
 		return
 	}
 
-	if key != "" && authorization != "" {
+	if len(credentials) > 1 {
 		resp.Diagnostics.AddError(
 			"Conflicting Authentication Credentials",
-			"Both 'api_key' and 'authorization' are provided. You must only provide one of these for Prowlarr authentication",
+			fmt.Sprintf("%s are provided. You must only provide one of these for Prowlarr authentication", strings.Join(credentials, ", ")),
+		)
+
+		return
+	}
+
+	basicAuth := BasicAuth{
+		Username: types.StringValue(os.Getenv("PROWLARR_BASIC_AUTH_USERNAME")),
+		Password: types.StringValue(os.Getenv("PROWLARR_BASIC_AUTH_PASSWORD")),
+	}
+
+	if !data.BasicAuth.IsNull() && !data.BasicAuth.IsUnknown() {
+		resp.Diagnostics.Append(data.BasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})...)
+	}
+
+	extraHeaders := make(map[string]string, len(data.ExtraHeaders.Elements()))
+	resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := helpers.ClientHeaders{
+		Extra:         extraHeaders,
+		APIKey:        key,
+		Authorization: authorization,
+		BasicUsername: basicAuth.Username.ValueString(),
+		BasicPassword: basicAuth.Password.ValueString(),
+	}.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Conflicting Headers",
+			err.Error(),
 		)
 
 		return
@@ -144,19 +378,93 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 	// Configuring client. API Key management could be changed once new options avail in sdk.
 	config := prowlarr.NewConfiguration()
 
-	if key != "" {
-		config.AddDefaultHeader("X-Api-Key", key)
+	for name, value := range headers {
+		config.AddDefaultHeader(name, value)
 	}
 
-	if authorization != "" {
-		config.AddDefaultHeader("Authorization", authorization)
+	maxRetries := getInt64Config(data.MaxRetries, "PROWLARR_MAX_RETRIES", defaultMaxRetries, &resp.Diagnostics)
+	retryWaitMin := getInt64Config(data.RetryWaitMin, "PROWLARR_RETRY_WAIT_MIN", defaultRetryWaitMin, &resp.Diagnostics)
+	retryWaitMax := getInt64Config(data.RetryWaitMax, "PROWLARR_RETRY_WAIT_MAX", defaultRetryWaitMax, &resp.Diagnostics)
+	waitForReadyTimeout := getInt64Config(data.WaitForReadyTimeout, "PROWLARR_WAIT_FOR_READY_TIMEOUT", 0, &resp.Diagnostics)
+
+	if retryWaitMin < 1 || retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("retry_wait_min must be at least 1 and not greater than retry_wait_max, got %d and %d", retryWaitMin, retryWaitMax),
+		)
 	}
 
+	tlsOptions := helpers.TLSOptions{
+		CACertificate:      getStringConfig(data.CACertificate, "PROWLARR_CA_CERTIFICATE"),
+		ClientCertificate:  getStringConfig(data.ClientCertificate, "PROWLARR_CLIENT_CERTIFICATE"),
+		ClientKey:          getStringConfig(data.ClientKey, "PROWLARR_CLIENT_KEY"),
+		InsecureSkipVerify: getBoolConfig(data.InsecureSkipVerify, "PROWLARR_INSECURE_SKIP_VERIFY", &resp.Diagnostics),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpTransport, err := helpers.NewHTTPTransport(tlsOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid TLS Configuration",
+			fmt.Sprintf("Unable to configure TLS for the Prowlarr client: %s", err),
+		)
+
+		return
+	}
+
+	transport := helpers.NewTransport(httpTransport)
+	transport.MaxRetries = int(maxRetries)
+	transport.RetryWaitMin = time.Duration(retryWaitMin) * time.Second
+	transport.RetryWaitMax = time.Duration(retryWaitMax) * time.Second
+
 	config.Servers[0].URL = url
+	config.HTTPClient = &http.Client{Transport: transport}
 	client := prowlarr.NewAPIClient(config)
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if waitForReadyTimeout > 0 {
+		if err := helpers.WaitForReady(ctx, client, time.Duration(waitForReadyTimeout)*time.Second, transport.RetryWaitMin, transport.RetryWaitMax); err != nil {
+			resp.Diagnostics.AddError(
+				"Prowlarr Not Ready",
+				fmt.Sprintf("Prowlarr at %s did not become ready within %d seconds: %s", url, waitForReadyTimeout, err),
+			)
+
+			return
+		}
+	}
+
+	var defaultTags []string
+
+	resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerData := &helpers.ProviderData{
+		Client:      client,
+		DefaultTags: helpers.NewDefaultTags(client, defaultTags),
+		TestOnApply: getBoolConfig(data.TestOnApply, "PROWLARR_TEST_ON_APPLY", &resp.Diagnostics),
+	}
+
+	if getBoolConfig(data.CheckSystemStatus, "PROWLARR_CHECK_SYSTEM_STATUS", &resp.Diagnostics) {
+		version, diags := helpers.CheckSystemStatus(ctx, client, url)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		providerData.Version = version
+		tflog.Debug(ctx, "connected to Prowlarr", map[string]any{"version": version})
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -164,6 +472,7 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 		// Applications
 		NewSyncProfileResource,
 		NewApplicationResource,
+		NewApplicationSyncResource,
 		NewApplicationLazyLibrarianResource,
 		NewApplicationLidarrResource,
 		NewApplicationMylarResource,
@@ -202,6 +511,9 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 
 		// Indexer
 		NewIndexerResource,
+		NewIndexerCardigannResource,
+		NewIndexerNewznabResource,
+		NewIndexerTorznabResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -227,6 +539,7 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 		NewNotificationWebhookResource,
 
 		// System
+		NewCommandResource,
 		NewHostResource,
 
 		// Tags
@@ -241,26 +554,36 @@ func (p *ProwlarrProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewSyncProfilesDataSource,
 		NewApplicationDataSource,
 		NewApplicationsDataSource,
+		NewApplicationSchemaDataSource,
+		NewApplicationSchemasDataSource,
 
 		// Download Clients
 		NewDownloadClientDataSource,
 		NewDownloadClientsDataSource,
+		NewDownloadClientSchemaDataSource,
+		NewDownloadClientSchemasDataSource,
 
 		// Indexer Proxies
 		NewIndexerProxyDataSource,
 		NewIndexerProxiesDataSource,
+		NewIndexerProxySchemaDataSource,
+		NewIndexerProxySchemasDataSource,
 
 		// Indexer
 		NewIndexerDataSource,
 		NewIndexersDataSource,
 		NewIndexerSchemaDataSource,
 		NewIndexerSchemasDataSource,
+		NewIndexerCategoriesDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
+		NewNotificationSchemaDataSource,
+		NewNotificationSchemasDataSource,
 
 		// System
+		NewConnectivityTestDataSource,
 		NewHostDataSource,
 		NewSystemStatusDataSource,
 
@@ -272,6 +595,59 @@ func (p *ProwlarrProvider) DataSources(_ context.Context) []func() datasource.Da
 	}
 }
 
+// getStringConfig returns the configured value, falling back to the environment variable.
+func getStringConfig(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// getBoolConfig returns the configured value, falling back to the environment variable.
+func getBoolConfig(value types.Bool, env string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diags.AddError(
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a boolean, got: %s", env, envValue),
+		)
+	}
+
+	return parsed
+}
+
+// getInt64Config returns the configured value, falling back to the environment variable and then to the default.
+func getInt64Config(value types.Int64, env string, defaultValue int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || parsed < 0 {
+		diags.AddError(
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a non negative integer, got: %s", env, envValue),
+		)
+	}
+
+	return parsed
+}
+
 // New returns the provider with a specific version.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
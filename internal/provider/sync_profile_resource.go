@@ -116,7 +116,7 @@ func (r *SyncProfileResource) Create(ctx context.Context, req resource.CreateReq
 
 	response, _, err := r.client.AppProfileApi.CreateAppProfile(ctx).AppProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, syncProfileResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, syncProfileResourceName, err)
 
 		return
 	}
@@ -138,9 +138,9 @@ func (r *SyncProfileResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get sync profile current value
-	response, _, err := r.client.AppProfileApi.GetAppProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.AppProfileApi.GetAppProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, syncProfileResourceName, err))
+		helpers.HandleReadError(ctx, syncProfileResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -166,7 +166,7 @@ func (r *SyncProfileResource) Update(ctx context.Context, req resource.UpdateReq
 
 	response, _, err := r.client.AppProfileApi.UpdateAppProfile(ctx, fmt.Sprint(request.GetId())).AppProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, syncProfileResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, syncProfileResourceName, err)
 
 		return
 	}
@@ -102,7 +102,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	response, _, err := r.client.TagApi.CreateTag(ctx).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Create, tagResourceName, err)
 
 		return
 	}
@@ -124,9 +124,9 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagApi.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagApi.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		helpers.HandleReadError(ctx, tagResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -154,7 +154,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	response, _, err := r.client.TagApi.UpdateTag(ctx, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.ProcessClientError(ctx, &resp.Diagnostics, resp.State.Schema, helpers.Update, tagResourceName, err)
 
 		return
 	}